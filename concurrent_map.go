@@ -0,0 +1,493 @@
+package monadgo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// ConcurrentMap represents a scala-like concurrent.Map.
+// It is safe for concurrent use by multiple goroutines.
+// Keys like Option and Tuple are compared with Equal.
+// All Traversable operations are applied on a consistent snapshot.
+type ConcurrentMap interface {
+	Map
+
+	// Lookup returns Some of value bound to k, or None if k does not exist.
+	Lookup(k interface{}) Option
+
+	// Put binds k to v, and returns Some of previous value or None.
+	Put(k, v interface{}) Option
+
+	// PutIfAbsent binds k to v if k does not exist.
+	// returns Some of existing value, or None if v is put.
+	PutIfAbsent(k, v interface{}) Option
+
+	// Remove removes k, and returns Some of removed value or None.
+	Remove(k interface{}) Option
+
+	// Compute atomically binds k to the result of f.
+	// k is removed if f returns None.
+	// f runs without blocking operations on other keys, and can Lookup k.
+	// f must not call Put, PutIfAbsent, Remove, Compute or GetOrCompute with k, which deadlocks.
+	// f: func(Option) Option, input is current binding of k.
+	// returns the result of f.
+	Compute(k, f interface{}) Option
+
+	// GetOrCompute returns value bound to k,
+	// or atomically binds k to the result of f and returns it if k does not exist.
+	// f runs without blocking operations on other keys, and can Lookup k.
+	// f must not call Put, PutIfAbsent, Remove, Compute or GetOrCompute with k, which deadlocks.
+	// f: func() V or func(K) V
+	GetOrCompute(k, f interface{}) interface{}
+
+	// GetOrComputeFuture is like GetOrCompute, but computes in a Future.
+	// Concurrent calls on the same absent k share one Future,
+	// and k is bound to the result only if the Future is successful.
+	// Values bound to k while f runs win like PutIfAbsent, and the Future completes with the bound value.
+	// f: func() V or func(K) V, V can be (X, error) or (X, bool).
+	GetOrComputeFuture(k, f interface{}) Future
+
+	// Snapshot returns a Map copying all bindings at this moment.
+	Snapshot() Map
+}
+
+type concurrentMap struct {
+	ktype   reflect.Type
+	vtype   reflect.Type
+	v       reflect.Value
+	keys    keyIndex
+	mux     *sync.RWMutex
+	pending map[interface{}]pendingFuture
+	locks   map[interface{}]*keyLock
+}
+
+// pendingFuture is a Future computing the value of key.
+type pendingFuture struct {
+	key reflect.Value
+	u   Future
+}
+
+// hashID is the identity of keys checked by isStructKey, and keys with the same hash share it.
+type hashID uint64
+
+// keyID returns the identity of kval for locks and pending Futures.
+func keyID(kval reflect.Value) interface{} {
+	if isStructKey(kval) {
+		return hashID(hashValue(kval))
+	}
+	return kval.Interface()
+}
+
+// keyLock serializes updates on a key, and is removed when no one holds or waits for it.
+type keyLock struct {
+	mux  sync.Mutex
+	refs int
+}
+
+var _ ConcurrentMap = &concurrentMap{}
+
+// ConcurrentMapOf returns a ConcurrentMap copying all bindings from x.
+// x can be anything accepted by MapOf.
+func ConcurrentMapOf(x interface{}) ConcurrentMap {
+	m := MapOf(x)
+	v := copyMap(m.rv())
+	return &concurrentMap{
+		ktype:   v.Type().Key(),
+		vtype:   v.Type().Elem(),
+		v:       v,
+		keys:    newKeyIndex(v),
+		mux:     &sync.RWMutex{},
+		pending: make(map[interface{}]pendingFuture),
+		locks:   make(map[interface{}]*keyLock),
+	}
+}
+
+// copyMap returns a reflect.Value of a copy of go map m.
+func copyMap(m reflect.Value) reflect.Value {
+	ret := makeMap(m.Type().Key(), m.Type().Elem(), m.Len())
+
+	it := m.MapRange()
+	for it.Next() {
+		ret.SetMapIndex(it.Key(), it.Value())
+	}
+	return ret
+}
+
+// ----------------------------------------------------------------------------
+
+func (m *concurrentMap) key(k interface{}) reflect.Value {
+	return valueOrZero(m.ktype, k)
+}
+
+func (m *concurrentMap) value(v interface{}) reflect.Value {
+	if v == null {
+		return reflect.Zero(m.vtype)
+	}
+	return valueOrZero(m.vtype, v)
+}
+
+// compute returns the result of f applied to kval.
+// f: func() V or func(K) V
+func (m *concurrentMap) compute(f interface{}, kval reflect.Value) reflect.Value {
+	if reflect.TypeOf(f).NumIn() == 0 {
+		return funcOf(f).call(unitValue)
+	}
+	return funcOf(f).call(kval)
+}
+
+// lockKey locks updates on kval without holding m.mux, and returns the unlock function.
+// Updates lock the key before m.mux, so that computing functions run outside m.mux.
+func (m *concurrentMap) lockKey(kval reflect.Value) func() {
+	k := keyID(kval)
+
+	m.mux.Lock()
+	l, ok := m.locks[k]
+	if !ok {
+		l = &keyLock{}
+		m.locks[k] = l
+	}
+	l.refs++
+	m.mux.Unlock()
+
+	l.mux.Lock()
+	return func() {
+		l.mux.Unlock()
+
+		m.mux.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(m.locks, k)
+		}
+		m.mux.Unlock()
+	}
+}
+
+// lookup returns Some of value bound to key equal to kval, or None. m.mux must be held.
+func (m *concurrentMap) lookup(kval reflect.Value) Option {
+	if v := m.v.MapIndex(m.keys.find(kval)); v.IsValid() {
+		return SomeOf(v)
+	}
+	return None
+}
+
+// bind binds key equal to kval to v, or removes the key if v is invalid. m.mux must be held.
+func (m *concurrentMap) bind(kval, v reflect.Value) {
+	if !v.IsValid() {
+		m.v.SetMapIndex(m.keys.find(kval), v)
+		m.keys.remove(kval)
+		return
+	}
+	m.v.SetMapIndex(m.keys.add(kval), v)
+}
+
+// Snapshot returns a Map copying all bindings at this moment.
+func (m *concurrentMap) Snapshot() Map {
+	defer m.mux.RUnlock()
+	m.mux.RLock()
+
+	return newMap(copyMap(m.v))
+}
+
+// Lookup returns Some of value bound to k, or None if k does not exist.
+func (m *concurrentMap) Lookup(k interface{}) Option {
+	defer m.mux.RUnlock()
+	m.mux.RLock()
+
+	return m.lookup(m.key(k))
+}
+
+// Put binds k to v, and returns Some of previous value or None.
+func (m *concurrentMap) Put(k, v interface{}) Option {
+	kval := m.key(k)
+	defer m.lockKey(kval)()
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	old := m.lookup(kval)
+	m.bind(kval, m.value(v))
+	return old
+}
+
+// PutIfAbsent binds k to v if k does not exist.
+// returns Some of existing value, or None if v is put.
+func (m *concurrentMap) PutIfAbsent(k, v interface{}) Option {
+	kval := m.key(k)
+	defer m.lockKey(kval)()
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	old := m.lookup(kval)
+	if old.Defined() {
+		return old
+	}
+	m.bind(kval, m.value(v))
+	return None
+}
+
+// Remove removes k, and returns Some of removed value or None.
+func (m *concurrentMap) Remove(k interface{}) Option {
+	kval := m.key(k)
+	defer m.lockKey(kval)()
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	old := m.lookup(kval)
+	m.bind(kval, reflect.Value{})
+	return old
+}
+
+// Compute atomically binds k to the result of f.
+// k is removed if f returns None.
+// f runs without blocking operations on other keys, and can Lookup k.
+// f must not call Put, PutIfAbsent, Remove, Compute or GetOrCompute with k, which deadlocks.
+// f: func(Option) Option, input is current binding of k.
+// returns the result of f.
+func (m *concurrentMap) Compute(k, f interface{}) Option {
+	kval := m.key(k)
+	defer m.lockKey(kval)()
+
+	ret := funcOf(f).invoke(m.Lookup(k)).(Option)
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	if ret.Defined() {
+		m.bind(kval, m.value(ret.Get()))
+	} else {
+		m.bind(kval, reflect.Value{})
+	}
+	return ret
+}
+
+// GetOrCompute returns value bound to k,
+// or atomically binds k to the result of f and returns it if k does not exist.
+// f runs without blocking operations on other keys, and can Lookup k.
+// f must not call Put, PutIfAbsent, Remove, Compute or GetOrCompute with k, which deadlocks.
+// f: func() V or func(K) V
+func (m *concurrentMap) GetOrCompute(k, f interface{}) interface{} {
+	kval := m.key(k)
+	defer m.lockKey(kval)()
+
+	if v := m.Lookup(k); v.Defined() {
+		return v.Get()
+	}
+
+	v := m.value(m.compute(f, kval).Interface())
+
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	m.bind(kval, v)
+	return v.Interface()
+}
+
+// GetOrComputeFuture is like GetOrCompute, but computes in a Future.
+// Concurrent calls on the same absent k share one Future,
+// and k is bound to the result only if the Future is successful.
+// Values bound to k while f runs win like PutIfAbsent, and the Future completes with the bound value.
+// f: func() V or func(K) V, V can be (X, error) or (X, bool).
+func (m *concurrentMap) GetOrComputeFuture(k, f interface{}) Future {
+	defer m.mux.Unlock()
+	m.mux.Lock()
+
+	kval := m.key(k)
+	if v := m.lookup(kval); v.Defined() {
+		return DefaultPromise(context.Background()).Success(v.Get())
+	}
+
+	id := keyID(kval)
+	p, collided := m.pending[id]
+	if collided && equalValue(p.key, kval) {
+		return p.u
+	}
+
+	u := FutureOf(func() Try {
+		result := safeTry(func() Try {
+			return tryCBF(m.compute(f, kval))
+		})
+
+		defer m.lockKey(kval)()
+
+		defer m.mux.Unlock()
+		m.mux.Lock()
+
+		if !collided {
+			delete(m.pending, id)
+		}
+		if result.OK() {
+			if old := m.lookup(kval); old.Defined() {
+				return newTraitTry(true, old.Get())
+			}
+			m.bind(kval, m.value(result.Get()))
+		}
+		return result
+	})
+
+	// u is not registered if a different key with the same hash is pending.
+	if !collided {
+		m.pending[id] = pendingFuture{kval, u}
+	}
+	return u
+}
+
+// ----------------------------------------------------------------------------
+
+func (m *concurrentMap) Get() interface{} {
+	return m.Snapshot().Get()
+}
+
+func (m *concurrentMap) rv() reflect.Value {
+	return m.Snapshot().rv()
+}
+
+func (m *concurrentMap) String() string {
+	return fmt.Sprintf("ConcurrentMap(%v)", m.Get())
+}
+
+func (m *concurrentMap) toSeq() seq {
+	return m.Snapshot().toSeq()
+}
+
+// Size returns the size.
+func (m *concurrentMap) Size() int {
+	defer m.mux.RUnlock()
+	m.mux.RLock()
+
+	return m.v.Len()
+}
+
+// Range returns a pair iterator on a snapshot.
+func (m *concurrentMap) Range() *PairIter {
+	return m.Snapshot().Range()
+}
+
+// Map applies function f to all elements in a snapshot.
+func (m *concurrentMap) Map(f interface{}) Traversable {
+	return m.Snapshot().Map(f)
+}
+
+// FlatMap applies f to all elements in a snapshot and builds a new Travesable from result.
+func (m *concurrentMap) FlatMap(f interface{}) Traversable {
+	return m.Snapshot().FlatMap(f)
+}
+
+// Fold folds the elements in a snapshot using specified associative binary operator.
+func (m *concurrentMap) Fold(z, f interface{}) interface{} {
+	return m.Snapshot().Fold(z, f)
+}
+
+// Foreach applies f to all element in a snapshot.
+func (m *concurrentMap) Foreach(f interface{}) {
+	m.Snapshot().Foreach(f)
+}
+
+// Forall tests whether a predicate holds for all elements in a snapshot.
+func (m *concurrentMap) Forall(f interface{}) bool {
+	return m.Snapshot().Forall(f)
+}
+
+// Reduce reduces the elements in a snapshot using the specified associative binary operator.
+func (m *concurrentMap) Reduce(f interface{}) interface{} {
+	return m.Snapshot().Reduce(f)
+}
+
+// ReduceOption reduces the elements in a snapshot, or returns None if it is empty.
+func (m *concurrentMap) ReduceOption(f interface{}) Option {
+	return m.Snapshot().ReduceOption(f)
+}
+
+// ReduceLeftOption reduces the elements in a snapshot, or returns None if it is empty.
+func (m *concurrentMap) ReduceLeftOption(f interface{}) Option {
+	return m.Snapshot().ReduceLeftOption(f)
+}
+
+// GroupBy groups elements in a snapshot.
+func (m *concurrentMap) GroupBy(f interface{}) Map {
+	return m.Snapshot().GroupBy(f)
+}
+
+// GroupMap groups mapped elements in a snapshot.
+func (m *concurrentMap) GroupMap(key, value interface{}) Map {
+	return m.Snapshot().GroupMap(key, value)
+}
+
+// GroupMapReduce groups and reduces mapped elements in a snapshot.
+func (m *concurrentMap) GroupMapReduce(key, value, reduce interface{}) Map {
+	return m.Snapshot().GroupMapReduce(key, value, reduce)
+}
+
+// CountBy counts elements in a snapshot by key.
+func (m *concurrentMap) CountBy(f interface{}) Map {
+	return m.Snapshot().CountBy(f)
+}
+
+// PartitionMap splits elements in a snapshot into Lefts and Rights.
+func (m *concurrentMap) PartitionMap(f interface{}) Tuple2 {
+	return m.Snapshot().PartitionMap(f)
+}
+
+// Exists tests whether a predicate holds for at least one element in a snapshot.
+func (m *concurrentMap) Exists(f interface{}) bool {
+	return m.Snapshot().Exists(f)
+}
+
+// Find returns the first pair satisfying f in a snapshot,
+// otherwise return None.
+func (m *concurrentMap) Find(f interface{}) Option {
+	return m.Snapshot().Find(f)
+}
+
+// Filter retuns all elements satisfying f in a snapshot.
+func (m *concurrentMap) Filter(f interface{}) Traversable {
+	return m.Snapshot().Filter(f)
+}
+
+// MkString displays all elements in a snapshot in a string using start, end, and separator sep.
+func (m *concurrentMap) MkString(start, sep, end string) string {
+	return m.Snapshot().MkString(start, sep, end)
+}
+
+// Split splits a snapshot into a unsatisfying and satisfying pair according to f.
+func (m *concurrentMap) Split(f interface{}) Tuple2 {
+	return m.Snapshot().Split(f)
+}
+
+// MapE applies f to all elements in a snapshot.
+func (m *concurrentMap) MapE(f interface{}, mode ...ErrorMode) Try {
+	return m.Snapshot().MapE(f, mode...)
+}
+
+// FlatMapE applies f to all elements in a snapshot and builds a new Travesable from result.
+func (m *concurrentMap) FlatMapE(f interface{}, mode ...ErrorMode) Try {
+	return m.Snapshot().FlatMapE(f, mode...)
+}
+
+// FoldE folds the elements in a snapshot.
+func (m *concurrentMap) FoldE(z, f interface{}, mode ...ErrorMode) Try {
+	return m.Snapshot().FoldE(z, f, mode...)
+}
+
+// ForeachE applies f to all element in a snapshot.
+func (m *concurrentMap) ForeachE(f interface{}, mode ...ErrorMode) Try {
+	return m.Snapshot().ForeachE(f, mode...)
+}
+
+// Collect returns elements in a snapshot satisfying pf.
+func (m *concurrentMap) Collect(pf PartialFunc) Traversable {
+	return m.Snapshot().Collect(pf)
+}
+
+// Equal reports whether a snapshot and other have deeply equal bindings.
+func (m *concurrentMap) Equal(other Any) bool {
+	return m.Snapshot().Equal(other)
+}
+
+// Hash returns the hash of bindings in a snapshot.
+func (m *concurrentMap) Hash() uint64 {
+	return m.Snapshot().Hash()
+}
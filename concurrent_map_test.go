@@ -0,0 +1,254 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func ExampleConcurrentMapOf() {
+	m := ConcurrentMapOf(map[string]int{"a": 1})
+
+	fmt.Println(m.Put("b", 2))
+	fmt.Println(m.Put("b", 22))
+	fmt.Println(m.PutIfAbsent("a", 11))
+	fmt.Println(m.PutIfAbsent("c", 3))
+	fmt.Println(m.Lookup("c"))
+	fmt.Println(m.Remove("c"))
+	fmt.Println(m.Lookup("c"))
+	printGet(m.Get())
+
+	// Output:
+	// None
+	// Some(2)
+	// Some(1)
+	// None
+	// Some(3)
+	// Some(3)
+	// None
+	// map[a:1 b:22], map[string]int
+}
+
+func ExampleConcurrentMap_Compute() {
+	m := ConcurrentMapOf(map[string]int{"a": 1})
+
+	inc := func(o Option) Option {
+		return o.Map(func(x int) int {
+			return x + 1
+		}).OrElse(func() Option {
+			return SomeOf(1)
+		})
+	}
+
+	fmt.Println(m.Compute("a", inc))
+	fmt.Println(m.Compute("b", inc))
+	fmt.Println(m.Compute("a", func(Option) Option {
+		return None
+	}))
+	printGet(m.Get())
+
+	// Output:
+	// Some(2)
+	// Some(1)
+	// None
+	// map[b:1], map[string]int
+}
+
+func ExampleConcurrentMap_GetOrCompute() {
+	m := ConcurrentMapOf(map[string]int{"a": 1})
+
+	fmt.Println(m.GetOrCompute("a", func() int {
+		return 100
+	}))
+	fmt.Println(m.GetOrCompute("bb", func(k string) int {
+		return len(k)
+	}))
+	printGet(m.Get())
+
+	// Output:
+	// 1
+	// 2
+	// map[a:1 bb:2], map[string]int
+}
+
+func TestConcurrentMap_GetOrComputeFuture(t *testing.T) {
+	m := ConcurrentMapOf(map[string]int{"a": 1})
+
+	if x := m.GetOrComputeFuture("a", func() int { return 100 }).Result(wait); x.Get() != 1 {
+		t.Errorf("expect 1, but %v", x)
+	}
+
+	if x := m.GetOrComputeFuture("b", func() int { return 2 }).Result(wait); x.Get() != 2 {
+		t.Errorf("expect 2, but %v", x)
+	}
+
+	if x := m.Lookup("b"); x.Get() != 2 {
+		t.Errorf("expect Some(2), but %v", x)
+	}
+
+	f := m.GetOrComputeFuture("c", func() (int, error) { return 0, fmt.Errorf("error") })
+	if x := f.Result(wait); x.Defined() {
+		t.Errorf("expect None, but %v", x)
+	}
+
+	if x := m.Lookup("c"); x.Defined() {
+		t.Errorf("expect None, but %v", x)
+	}
+}
+
+func TestConcurrentMap_GetOrComputeFuture_Panic(t *testing.T) {
+	m := ConcurrentMapOf(map[string]int{})
+
+	f := m.GetOrComputeFuture("a", func() int { panic("compute") })
+	if x := f.Result(wait); x.Defined() {
+		t.Errorf("expect None, but %v", x)
+	}
+
+	if x := m.GetOrComputeFuture("a", func() int { return 1 }).Result(wait); x.Get() != 1 {
+		t.Errorf("expect 1 after a panicking computation, but %v", x)
+	}
+}
+
+func TestConcurrentMap_GetOrComputeFuture_Put(t *testing.T) {
+	m := ConcurrentMapOf(map[string]int{})
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	f := m.GetOrComputeFuture("a", func() int {
+		close(started)
+		<-release
+		return 1
+	})
+
+	<-started
+	m.Put("a", 2)
+	close(release)
+
+	if x := f.Result(wait); x.Get() != 2 {
+		t.Errorf("expect 2 put while computing, but %v", x)
+	}
+
+	if x := m.Lookup("a"); x.Get() != 2 {
+		t.Errorf("expect Some(2), but %v", x)
+	}
+}
+
+func TestConcurrentMap_ComputeReentrant(t *testing.T) {
+	m := ConcurrentMapOf(map[string]int{"a": 1})
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		m.Compute("a", func(o Option) Option {
+			m.Put("b", m.Lookup("a").Get())
+			return SomeOf(o.Get().(int) + m.Size())
+		})
+
+		m.GetOrCompute("c", func() int {
+			return m.GetOrCompute("d", func() int {
+				return m.Lookup("b").Get().(int) + 1
+			}).(int)
+		})
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(wait):
+		t.Fatal("computing functions using the map deadlock")
+	}
+
+	expected := map[string]int{"a": 3, "b": 1, "c": 2, "d": 2}
+	if !reflect.DeepEqual(m.Get(), expected) {
+		t.Errorf("expect %v, but %v", expected, m.Get())
+	}
+}
+
+func TestConcurrentMap_ComputeBlocking(t *testing.T) {
+	m := ConcurrentMapOf(map[string]int{})
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	go m.Compute("slow", func(Option) Option {
+		close(started)
+		<-release
+		return SomeOf(1)
+	})
+	<-started
+
+	done := make(chan struct{})
+	go func() {
+		m.Put("other", 1)
+		m.Compute("other", func(o Option) Option { return SomeOf(o.Get().(int) + 1) })
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(wait):
+		t.Fatal("Compute blocks other keys")
+	}
+	close(release)
+
+	if x := m.Lookup("other"); x.Get() != 2 {
+		t.Errorf("expect Some(2), but %v", x)
+	}
+}
+
+func TestConcurrentMap_Stress(t *testing.T) {
+	const workers = 16
+	const loops = 200
+
+	m := ConcurrentMapOf(map[int]int{})
+	counter := ConcurrentMapOf(map[string]int{})
+	computed := ConcurrentMapOf(map[int]int{})
+
+	inc := func(o Option) Option {
+		return SomeOf(o.GetOrElse(0).(int) + 1)
+	}
+
+	wg := &sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < loops; i++ {
+				m.PutIfAbsent(i, w)
+				counter.Compute("count", inc)
+				m.GetOrCompute(i+loops, func(k int) int {
+					computed.Compute(k, inc)
+					return k
+				})
+				m.GetOrComputeFuture(i+2*loops, func(k int) int {
+					computed.Compute(k, inc)
+					return k
+				}).Result(wait)
+
+				snap := m.Snapshot()
+				n := 0
+				it := snap.Range()
+				for it.Next() {
+					n++
+				}
+				if n != snap.Size() || n > m.Size() {
+					t.Errorf("inconsistent snapshot: %d, %d", n, snap.Size())
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if x := counter.Lookup("count"); x.Get() != workers*loops {
+		t.Errorf("expect %d, but %v", workers*loops, x)
+	}
+
+	if m.Size() != 3*loops {
+		t.Errorf("expect size %d, but %d", 3*loops, m.Size())
+	}
+
+	if !computed.Forall(func(_, v int) bool { return v == 1 }) {
+		t.Errorf("compute more than once: %v", computed)
+	}
+}
@@ -1,2 +1,8 @@
 // Package monadgo is toolkit about implementing Scala monadic operations, like map, flatMap, fold, foreach, forall and etc.
+//
+// Partial operations which panic or return nil on invalid input have Option or Try counterparts,
+// like Head and HeadOption, Reduce and ReduceOption, MapOf and TryMapOf.
+//
+// Panics in functions invoked by Try, Future and Task are recovered as Failure of PanicError,
+// which keeps the recovered value and the stack trace.
 package monadgo
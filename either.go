@@ -18,6 +18,17 @@ type Either interface {
 	// Left projects this Either as a Left.
 	Left() LeftProjection
 
+	// Right projects this Either as a Right.
+	Right() RightProjection
+
+	// Swap returns Right with the value of Left if this is a Left,
+	// or Left with the value of Right if this is a Right.
+	Swap() Either
+
+	// Merge returns the value of Left or Right.
+	// It is useful when both sides share the same type.
+	Merge() interface{}
+
 	// FilterOrElse returns Right with the existing value of Right if this is a Right and the given predicate p holds for the right value,
 	// or Left(zero) if this is a Right and the given predicate p does not hold for the right value,
 	// or Left with the existing value of Left if this is a Left.
@@ -56,13 +67,66 @@ type Either interface {
 	// returns new Either.
 	FlatMap(f interface{}) Either
 
+	// LeftMap applies f to the value if this is a Left, and keeps right-biased.
+	// f: func(A) C
+	// returns Either[C, B]
+	LeftMap(f interface{}) Either
+
+	// BiMap applies fl if this is a Left, or fr if this is a Right.
+	// fl: func(A) C
+	// fr: func(B) D
+	// returns Either[C, D]
+	BiMap(fl, fr interface{}) Either
+
+	// Flatten is the same as JoinRight.
+	Flatten() Either
+
+	// JoinRight returns the nested Either if this is Right of Either,
+	// otherwise return this.
+	JoinRight() Either
+
+	// JoinLeft returns the nested Either if this is Left of Either,
+	// otherwise return this.
+	JoinLeft() Either
+
+	// Contains returns true if this is a Right and the value equals x.
+	Contains(x interface{}) bool
+
 	// GetOrElse returns the value from this Right,
 	// or z if this is a Left.
 	GetOrElse(z interface{}) interface{}
 
+	// Zip returns Right of Tuple2 with values of this and that if both are Right,
+	// otherwise return the first Left.
+	Zip(that Either) Either
+
+	// ZipWith returns Right of result from applying f to values of this and that if both are Right,
+	// otherwise return the first Left.
+	// f: func(T, U) X
+	ZipWith(that Either, f interface{}) Either
+
 	// ToOption returns a Some containing the Right value if it exists,
 	// or a None if this is a Left.
 	ToOption() Option
+
+	// ToGo returns the value and nil if this is a Right,
+	// or nil and the value of Left as an error if this is a Left. Left of false returns ErrFalse.
+	ToGo() (interface{}, error)
+
+	// ToTry returns Success of the value if this is a Right,
+	// or Failure if this is a Left.
+	// The value of Left is kept if it is error or false, otherwise it is converted to an error.
+	ToTry() Try
+
+	// Equal reports whether this and other are on the same side with deeply equal values.
+	Equal(other Any) bool
+
+	// Hash returns the hash of this, consistent with Equal.
+	Hash() uint64
+
+	// Compare compares this and other. Left is less than Right, and values on the same side are compared.
+	// returns -1 if this < other, 0 if this == other, and +1 if this > other.
+	Compare(other Either) int
 }
 
 type traitEither struct {
@@ -99,6 +163,23 @@ func (e *traitEither) Left() LeftProjection {
 	}
 }
 
+func (e *traitEither) Right() RightProjection {
+	return &rightProjection{
+		e: e,
+	}
+}
+
+func (e *traitEither) Swap() Either {
+	return &traitEither{
+		right: !e.right,
+		v:     e.v,
+	}
+}
+
+func (e *traitEither) Merge() interface{} {
+	return e.Get()
+}
+
 func (e *traitEither) Forall(f interface{}) bool {
 	if e.IsLeft() {
 		return true
@@ -158,6 +239,57 @@ func (e *traitEither) FlatMap(f interface{}) Either {
 	return e
 }
 
+func (e *traitEither) LeftMap(f interface{}) Either {
+	if e.IsLeft() {
+		return &traitEither{
+			right: false,
+			v:     funcOf(f).call(e.v),
+		}
+	}
+
+	return e
+}
+
+func (e *traitEither) BiMap(fl, fr interface{}) Either {
+	if e.IsLeft() {
+		return e.LeftMap(fl)
+	}
+
+	return e.Map(fr)
+}
+
+func (e *traitEither) Flatten() Either {
+	return e.JoinRight()
+}
+
+func (e *traitEither) JoinRight() Either {
+	if e.IsRight() {
+		if x, ok := e.Get().(Either); ok {
+			return x
+		}
+	}
+
+	return e
+}
+
+func (e *traitEither) JoinLeft() Either {
+	if e.IsLeft() {
+		if x, ok := e.Get().(Either); ok {
+			return x
+		}
+	}
+
+	return e
+}
+
+func (e *traitEither) Contains(x interface{}) bool {
+	if e.IsLeft() {
+		return false
+	}
+
+	return reflect.DeepEqual(e.Get(), x)
+}
+
 func (e *traitEither) GetOrElse(z interface{}) interface{} {
 	if e.IsLeft() {
 		return checkAndInvoke(z)
@@ -165,6 +297,14 @@ func (e *traitEither) GetOrElse(z interface{}) interface{} {
 	return e.Get()
 }
 
+func (e *traitEither) Zip(that Either) Either {
+	return mapNEither(nil, e, that)
+}
+
+func (e *traitEither) ZipWith(that Either, f interface{}) Either {
+	return mapNEither(f, e, that)
+}
+
 func (e *traitEither) ToOption() Option {
 	if e.IsLeft() {
 		return None
@@ -173,6 +313,38 @@ func (e *traitEither) ToOption() Option {
 	return OptionOf(e.Get())
 }
 
+func (e *traitEither) ToGo() (interface{}, error) {
+	if e.IsRight() {
+		return e.Get(), nil
+	}
+
+	return nil, errorOf(e.Get())
+}
+
+func (e *traitEither) ToTry() Try {
+	if e.IsRight() {
+		return &traitTry{ok: true, v: e.v}
+	}
+
+	if yes, _ := isErrorOrFalse(e.Get()); yes {
+		return newTraitTry(false, e.Get())
+	}
+
+	return newTraitTry(false, errorOf(e.Get()))
+}
+
+func (e *traitEither) Equal(other Any) bool {
+	return Equal(e, other)
+}
+
+func (e *traitEither) Hash() uint64 {
+	return Hash(e)
+}
+
+func (e *traitEither) Compare(other Either) int {
+	return Compare(e, other)
+}
+
 // ----------------------------------------------------------------------------
 
 // LeftOf returns Left of x.
@@ -180,6 +352,17 @@ func LeftOf(x ...interface{}) Either {
 	return eitherCBF(false, x...)
 }
 
+// CondEither returns Right of right if test is true, otherwise return Left of left.
+// right: func() B or value with type B
+// left: func() A or value with type A
+func CondEither(test bool, right, left interface{}) Either {
+	if test {
+		return RightOf(checkAndInvoke(right))
+	}
+
+	return LeftOf(checkAndInvoke(left))
+}
+
 // RightOf returns Right of x.
 func RightOf(x ...interface{}) Either {
 	return eitherCBF(true, x...)
@@ -1,6 +1,7 @@
 package monadgo
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -408,3 +409,71 @@ func ExampleEither_Exists() {
 	// false, bool
 	// false, bool
 }
+
+func ExampleEither_Swap() {
+	fmt.Println(RightOf(1).Swap(), LeftOf("a").Swap())
+	fmt.Println(RightOf(1).Merge(), LeftOf("a").Merge())
+
+	// Output:
+	// Left(1) Right(a)
+	// 1 a
+}
+
+func ExampleEither_BiMap() {
+	length := func(s string) int {
+		return len(s)
+	}
+	double := func(x int) int {
+		return x * 2
+	}
+
+	fmt.Println(LeftOf("abc").LeftMap(length), RightOf(1).LeftMap(length))
+	fmt.Println(LeftOf("abc").BiMap(length, double), RightOf(1).BiMap(length, double))
+
+	// LeftProjection.Map returns right-biased Either
+	e := LeftOf("abc").Left().Map(length).Map(double)
+	fmt.Println(e)
+
+	// Output:
+	// Left(3) Right(1)
+	// Left(3) Right(2)
+	// Left(3)
+}
+
+func ExampleEither_JoinRight() {
+	fmt.Println(RightOf(RightOf(1)).JoinRight(), RightOf(LeftOf("a")).Flatten(), LeftOf("a").JoinRight())
+	fmt.Println(LeftOf(RightOf(1)).JoinLeft(), LeftOf(LeftOf("a")).JoinLeft(), RightOf(1).JoinLeft())
+
+	// Output:
+	// Right(1) Left(a) Left(a)
+	// Right(1) Left(a) Right(1)
+}
+
+func ExampleEither_Contains() {
+	fmt.Println(RightOf(1).Contains(1), RightOf(1).Contains(2), LeftOf(1).Contains(1))
+
+	// Output:
+	// true false false
+}
+
+func ExampleEither_ToTry() {
+	fmt.Println(RightOf(1).ToTry())
+	fmt.Println(LeftOf(errors.New("oops")).ToTry())
+	fmt.Println(LeftOf(false).ToTry())
+	fmt.Println(LeftOf("bad input").ToTry())
+
+	// Output:
+	// Success(1)
+	// Failure(oops)
+	// Failure(false)
+	// Failure(bad input)
+}
+
+func ExampleCondEither() {
+	fmt.Println(CondEither(true, 1, "a"), CondEither(false, 1, func() string {
+		return "a"
+	}))
+
+	// Output:
+	// Right(1) Left(a)
+}
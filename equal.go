@@ -0,0 +1,439 @@
+package monadgo
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// structOf returns the tag and children of x if x is Option, Either, Try, Tuple, Pair, Slice or Map.
+// Slice and Map have their Go slice and Go map as the only child.
+func structOf(x interface{}) (string, []reflect.Value, bool) {
+	switch v := x.(type) {
+	case Option:
+		if v.Defined() {
+			return "Some", []reflect.Value{v.rv()}, true
+		}
+		return "None", nil, true
+	case Either:
+		if v.IsRight() {
+			return "Right", []reflect.Value{v.rv()}, true
+		}
+		return "Left", []reflect.Value{v.rv()}, true
+	case Try:
+		if v.OK() {
+			return "Success", []reflect.Value{v.rv()}, true
+		}
+		return "Failure", []reflect.Value{v.rv()}, true
+	case Pair:
+		return "Tuple", v.toValues(), true
+	case Tuple:
+		return "Tuple", v.toValues(), true
+	case Map:
+		return "Map", []reflect.Value{reflect.ValueOf(v.Get())}, true
+	case Slice:
+		return "Slice", []reflect.Value{reflect.ValueOf(v.Get())}, true
+	}
+
+	return "", nil, false
+}
+
+// structOfValue returns structOf v if v can be converted to interface{}.
+func structOfValue(v reflect.Value) (string, []reflect.Value, bool) {
+	if !v.CanInterface() {
+		return "", nil, false
+	}
+	return structOf(v.Interface())
+}
+
+// elemOf returns the value in v if v is an interface.
+func elemOf(v reflect.Value) reflect.Value {
+	for v.IsValid() && v.Kind() == reflect.Interface {
+		v = v.Elem()
+	}
+	return v
+}
+
+func isSeqKind(v reflect.Value) bool {
+	return v.Kind() == reflect.Slice || v.Kind() == reflect.Array
+}
+
+// ----------------------------------------------------------------------------
+
+// equalValue reports whether x and y are deeply equal.
+// Go slices and arrays are equal if they have equal elements, and Go maps are equal if they have equal keys and values.
+// Other Go values are equal if they have the same type and are equal with reflect.DeepEqual.
+func equalValue(x, y reflect.Value) bool {
+	x, y = elemOf(x), elemOf(y)
+
+	if !x.IsValid() || !y.IsValid() {
+		return x.IsValid() == y.IsValid()
+	}
+
+	xtag, xs, xok := structOfValue(x)
+	ytag, ys, yok := structOfValue(y)
+	if xok || yok {
+		return xok && yok && xtag == ytag && equalValues(xs, ys)
+	}
+
+	switch {
+	case isSeqKind(x):
+		if !isSeqKind(y) || x.Len() != y.Len() {
+			return false
+		}
+
+		for i := 0; i < x.Len(); i++ {
+			if !equalValue(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case x.Kind() == reflect.Map:
+		if y.Kind() != reflect.Map || x.Len() != y.Len() {
+			return false
+		}
+
+		it := x.MapRange()
+		for it.Next() {
+			v, ok := lookupValue(y, it.Key())
+			if !ok || !equalValue(it.Value(), v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if x.Type() != y.Type() || !x.CanInterface() || !y.CanInterface() {
+		return false
+	}
+
+	return reflect.DeepEqual(x.Interface(), y.Interface())
+}
+
+func equalValues(xs, ys []reflect.Value) bool {
+	if len(xs) != len(ys) {
+		return false
+	}
+
+	for i := range xs {
+		if !equalValue(xs[i], ys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookupValue returns the value with key equal to k in Go map m.
+func lookupValue(m, k reflect.Value) (reflect.Value, bool) {
+	if k.Type().AssignableTo(m.Type().Key()) && k.Type().Comparable() {
+		if v := m.MapIndex(k); v.IsValid() {
+			return v, true
+		}
+	}
+
+	it := m.MapRange()
+	for it.Next() {
+		if equalValue(it.Key(), k) {
+			return it.Value(), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
+// ----------------------------------------------------------------------------
+
+// hashValue returns the hash of v, consistent with equalValue.
+func hashValue(v reflect.Value) uint64 {
+	h := fnv.New64a()
+	writeHash(h, v)
+	return h.Sum64()
+}
+
+func writeUint64(h hash.Hash64, x uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], x)
+	h.Write(buf[:])
+}
+
+func writeHash(h hash.Hash64, v reflect.Value) {
+	v = elemOf(v)
+
+	if !v.IsValid() {
+		h.Write([]byte("nil"))
+		return
+	}
+
+	if tag, children, ok := structOfValue(v); ok {
+		h.Write([]byte(tag))
+		for _, c := range children {
+			writeHash(h, c)
+		}
+		return
+	}
+
+	if isSeqKind(v) {
+		h.Write([]byte("seq"))
+		writeUint64(h, uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			writeHash(h, v.Index(i))
+		}
+		return
+	}
+
+	if v.Kind() == reflect.Map {
+		// entries are summed up, so that the hash does not depend on the order of keys.
+		var sum uint64
+		it := v.MapRange()
+		for it.Next() {
+			sum += hashValue(it.Key())*31 + hashValue(it.Value())
+		}
+		h.Write([]byte("map"))
+		writeUint64(h, sum)
+		return
+	}
+
+	h.Write([]byte(v.Type().String()))
+
+	switch v.Kind() {
+	case reflect.Bool:
+		if v.Bool() {
+			writeUint64(h, 1)
+		} else {
+			writeUint64(h, 0)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		writeUint64(h, uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		writeUint64(h, v.Uint())
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if f == 0 {
+			// +0 and -0 are equal.
+			f = 0
+		}
+		writeUint64(h, math.Float64bits(f))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		writeUint64(h, math.Float64bits(real(c)))
+		writeUint64(h, math.Float64bits(imag(c)))
+	case reflect.String:
+		h.Write([]byte(v.String()))
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			writeHash(h, v.Field(i))
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// isStructKey reports whether map key k is Option, Either, Try, Tuple, Pair, Slice or Map,
+// which is compared by Equal instead of Go ==.
+func isStructKey(k reflect.Value) bool {
+	_, _, ok := structOfValue(k)
+	return ok
+}
+
+// keyIndex indexes keys of a Go map by hash, so that keys equal with Equal are the same key.
+// Only keys checked by isStructKey are indexed, other keys are compared with Go ==.
+type keyIndex map[uint64][]reflect.Value
+
+// newKeyIndex returns a keyIndex of keys in Go map m.
+func newKeyIndex(m reflect.Value) keyIndex {
+	idx := make(keyIndex)
+	idx.merge(m)
+	return idx
+}
+
+// merge indexes keys in Go map m.
+func (idx keyIndex) merge(m reflect.Value) {
+	it := m.MapRange()
+	for it.Next() {
+		idx.add(it.Key())
+	}
+}
+
+// find returns the indexed key equal to k, or k if not found.
+func (idx keyIndex) find(k reflect.Value) reflect.Value {
+	if !isStructKey(k) {
+		return k
+	}
+
+	for _, x := range idx[hashValue(k)] {
+		if equalValue(x, k) {
+			return x
+		}
+	}
+	return k
+}
+
+// add indexes k if no indexed key is equal to k, and returns the indexed key.
+func (idx keyIndex) add(k reflect.Value) reflect.Value {
+	if !isStructKey(k) {
+		return k
+	}
+
+	h := hashValue(k)
+	for _, x := range idx[h] {
+		if equalValue(x, k) {
+			return x
+		}
+	}
+
+	idx[h] = append(idx[h], k)
+	return k
+}
+
+// remove removes the indexed key equal to k.
+func (idx keyIndex) remove(k reflect.Value) {
+	if !isStructKey(k) {
+		return
+	}
+
+	h := hashValue(k)
+	keys := idx[h]
+	for i, x := range keys {
+		if equalValue(x, k) {
+			keys = append(keys[:i:i], keys[i+1:]...)
+			break
+		}
+	}
+
+	if len(keys) > 0 {
+		idx[h] = keys
+	} else {
+		delete(idx, h)
+	}
+}
+
+// mapKey returns the key in Go map m equal to k, or k if not found.
+// It scans keys of m if k is checked by isStructKey.
+func mapKey(m, k reflect.Value) reflect.Value {
+	if !isStructKey(k) {
+		return k
+	}
+
+	it := m.MapRange()
+	for it.Next() {
+		if equalValue(it.Key(), k) {
+			return it.Key()
+		}
+	}
+	return k
+}
+
+// ----------------------------------------------------------------------------
+
+// tagRanks orders tags in the same family.
+var tagRanks = map[string]int{
+	"None": 0, "Some": 1,
+	"Left": 0, "Right": 1,
+	"Failure": 0, "Success": 1,
+}
+
+// tagFamilies groups tags which are comparable with each other.
+var tagFamilies = map[string]string{
+	"None": "Option", "Some": "Option",
+	"Left": "Either", "Right": "Either",
+	"Failure": "Try", "Success": "Try",
+	"Tuple": "Tuple",
+	"Slice": "Slice",
+	"Map":   "Map",
+}
+
+// compareDeep compares x and y.
+// None < Some, Left < Right, Failure < Success, and values in the same side are compared.
+// Tuples, Slices, Go slices and Go arrays are compared lexicographically.
+// Errors are compared with their messages, and false < true.
+// returns -1 if x < y, 0 if x == y, and +1 if x > y.
+// It panics if x and y are not ordered, like Map.
+func compareDeep(x, y reflect.Value) int {
+	x, y = elemOf(x), elemOf(y)
+
+	if !x.IsValid() || !y.IsValid() {
+		return compareOrdered(!x.IsValid() && y.IsValid(), x.IsValid() && !y.IsValid())
+	}
+
+	xtag, xs, xok := structOfValue(x)
+	ytag, ys, yok := structOfValue(y)
+	if xok || yok {
+		if !xok || !yok || tagFamilies[xtag] != tagFamilies[ytag] || xtag == "Map" {
+			panic(fmt.Sprintf("%v and %v are not ordered", x.Type(), y.Type()))
+		}
+
+		if xtag != ytag {
+			return compareOrdered(tagRanks[xtag] < tagRanks[ytag], tagRanks[xtag] > tagRanks[ytag])
+		}
+
+		return compareValues(xs, ys)
+	}
+
+	if isSeqKind(x) && isSeqKind(y) {
+		xs := make([]reflect.Value, x.Len())
+		for i := range xs {
+			xs[i] = x.Index(i)
+		}
+
+		ys := make([]reflect.Value, y.Len())
+		for i := range ys {
+			ys[i] = y.Index(i)
+		}
+
+		return compareValues(xs, ys)
+	}
+
+	if x.CanInterface() && y.CanInterface() {
+		xerr, xok := x.Interface().(error)
+		yerr, yok := y.Interface().(error)
+		if xok && yok {
+			return compareOrdered(xerr.Error() < yerr.Error(), xerr.Error() > yerr.Error())
+		}
+	}
+
+	if x.Kind() == reflect.Bool && y.Kind() == reflect.Bool {
+		return compareOrdered(!x.Bool() && y.Bool(), x.Bool() && !y.Bool())
+	}
+
+	return compareValue(x, y)
+}
+
+// compareValues compares xs and ys lexicographically.
+func compareValues(xs, ys []reflect.Value) int {
+	for i := 0; i < len(xs) && i < len(ys); i++ {
+		if c := compareDeep(xs[i], ys[i]); c != 0 {
+			return c
+		}
+	}
+
+	return compareOrdered(len(xs) < len(ys), len(xs) > len(ys))
+}
+
+// ----------------------------------------------------------------------------
+
+// Equal reports whether x and y are deeply equal.
+// Option, Either, Try, Tuple, Pair, Slice and Map are equal if they have the same structure and equal values.
+// Pair and Tuple2 with equal values are equal.
+func Equal(x, y interface{}) bool {
+	return equalValue(valueOf(x), valueOf(y))
+}
+
+// Hash returns the hash of x.
+// Values equal with Equal have the same hash, so that Option and Tuple can be keys by their hashes,
+// like keys of GroupBy, MultiMap and ConcurrentMap, and elements of Slice.Distinct.
+func Hash(x interface{}) uint64 {
+	return hashValue(valueOf(x))
+}
+
+// Compare compares x and y.
+// None < Some, Left < Right, Failure < Success, and values in the same side are compared.
+// Tuple and Slice are compared lexicographically.
+// returns -1 if x < y, 0 if x == y, and +1 if x > y.
+// It panics if x and y are not ordered.
+func Compare(x, y interface{}) int {
+	return compareDeep(valueOf(x), valueOf(y))
+}
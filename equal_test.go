@@ -0,0 +1,173 @@
+package monadgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleEqual() {
+	fmt.Println(SomeOf([]int{1, 2}).Equal(SomeOf([]int{1, 2})))
+	fmt.Println(SomeOf(1).Equal(None))
+	fmt.Println(None.Equal(None))
+	fmt.Println(RightOf(1).Equal(LeftOf(1)))
+	fmt.Println(FailureOf(errors.New("oops")).Equal(FailureOf(errors.New("oops"))))
+	fmt.Println(Tuple2Of(SomeOf(1), "a").Equal(Tuple2Of(SomeOf(1), "a")))
+	fmt.Println(PairOf("a", 1).Equal(Tuple2Of("a", 1)))
+	fmt.Println(SliceOf([]Option{SomeOf(1), None}).Equal(SliceOf([]Option{SomeOf(1), None})))
+	fmt.Println(MapOf(map[string][]int{"a": {1}}).Equal(MapOf(map[string][]int{"a": {1}})))
+	fmt.Println(Equal(SomeOf(1), SomeOf(int64(1))))
+
+	// Output:
+	// true
+	// false
+	// true
+	// false
+	// true
+	// true
+	// true
+	// true
+	// true
+	// false
+}
+
+func ExampleCompare() {
+	fmt.Println(None.Compare(SomeOf(1)))
+	fmt.Println(SomeOf(2).Compare(SomeOf(1)))
+	fmt.Println(LeftOf(3).Compare(RightOf(1)))
+	fmt.Println(FailureOf(errors.New("b")).Compare(FailureOf(errors.New("a"))))
+	fmt.Println(Tuple2Of(1, "b").Compare(Tuple2Of(1, "c")))
+	fmt.Println(SliceOf([]int{1, 2}).Compare(SliceOf([]int{1, 2, 0})))
+	fmt.Println(Compare(SomeOf(Tuple2Of(1, 2)), SomeOf(Tuple2Of(1, 2))))
+
+	// Output:
+	// -1
+	// 1
+	// -1
+	// 1
+	// -1
+	// -1
+	// 0
+}
+
+func ExampleSlice_Distinct() {
+	s := SliceOf([]Tuple2{
+		Tuple2Of(1, SomeOf("a")),
+		Tuple2Of(2, None),
+		Tuple2Of(1, SomeOf("a")),
+		Tuple2Of(2, SomeOf("b")),
+		Tuple2Of(2, None),
+	})
+	fmt.Println(s.Distinct())
+
+	// Output:
+	// [(1,Some(a)) (2,None) (2,Some(b))]
+}
+
+func TestHash(t *testing.T) {
+	pairs := [][2]interface{}{
+		{SomeOf([]int{1, 2}), SomeOf([]int{1, 2})},
+		{None, None},
+		{LeftOf("a"), LeftOf("a")},
+		{SuccessOf(Tuple2Of(1, "a")), SuccessOf(Tuple2Of(1, "a"))},
+		{PairOf("a", 1), Tuple2Of("a", 1)},
+		{SliceOf([]int{}), SliceOf([]string{})},
+		{MapOf(map[string]int{"a": 1, "b": 2}), MapOf(map[string]int{"b": 2, "a": 1})},
+		{ConcurrentMapOf(map[string]int{"a": 1}), MapOf(map[string]int{"a": 1})},
+		{SomeOf(0.0), SomeOf(-1 * 0.0)},
+	}
+
+	for _, p := range pairs {
+		if !Equal(p[0], p[1]) {
+			t.Errorf("%v and %v should be equal", p[0], p[1])
+		}
+		if Hash(p[0]) != Hash(p[1]) {
+			t.Errorf("hashes of %v and %v should be equal", p[0], p[1])
+		}
+	}
+
+	if SomeOf(1).Hash() == SomeOf(2).Hash() {
+		t.Errorf("hashes of Some(1) and Some(2) should differ")
+	}
+	if LeftOf(1).Hash() == RightOf(1).Hash() {
+		t.Errorf("hashes of Left(1) and Right(1) should differ")
+	}
+}
+
+func TestCompare_Map(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Compare on Map should panic")
+		}
+	}()
+
+	Compare(MapOf(map[string]int{"a": 1}), MapOf(map[string]int{"a": 1}))
+}
+
+func TestHash_Key(t *testing.T) {
+	counts := make(map[uint64][]Option)
+	for _, x := range []Option{SomeOf(1), None, SomeOf(1), None, SomeOf(2)} {
+		counts[x.Hash()] = append(counts[x.Hash()], x)
+	}
+
+	if len(counts) != 3 {
+		t.Errorf("expect 3 keys, got %d", len(counts))
+	}
+}
+
+func TestHash_MapKeys(t *testing.T) {
+	options := SliceOf([]Option{SomeOf(1), None, SomeOf(1), None, SomeOf(2)})
+	id := func(x Option) Option { return x }
+
+	counts := options.CountBy(id)
+	if counts.Size() != 3 || !counts.Equal(MapOf(map[Option]int{SomeOf(1): 2, None: 2, SomeOf(2): 1})) {
+		t.Errorf("expect 3 keys, got %v", counts)
+	}
+
+	if groups := options.GroupBy(id); groups.Size() != 3 {
+		t.Errorf("expect 3 keys, got %v", groups)
+	}
+
+	pairs := MapOf([]Pair{PairOf(Tuple2Of(1, "a"), 1), PairOf(Tuple2Of(1, "a"), 2), PairOf(Tuple2Of(2, "b"), 3)})
+	if !pairs.Equal(MapOf(map[Tuple2]int{Tuple2Of(1, "a"): 2, Tuple2Of(2, "b"): 3})) {
+		t.Errorf("expect 2 keys, got %v", pairs)
+	}
+
+	mm := MultiMapOf(map[Option][]int{})
+	mm.Add(SomeOf(1), 1).Add(SomeOf(1), 2).Add(None, 3)
+	if mm.KeyCount() != 2 || mm.Values(SomeOf(1)).Len() != 2 {
+		t.Errorf("expect 2 keys and 2 values of Some(1), got %v", mm)
+	}
+	if mm.Remove(SomeOf(1), 1).Remove(SomeOf(1), 2).KeyCount() != 1 {
+		t.Errorf("expect 1 key, got %v", mm)
+	}
+
+	cm := ConcurrentMapOf(map[Tuple2]int{})
+	cm.Put(Tuple2Of(1, "a"), 1)
+	if old := cm.Put(Tuple2Of(1, "a"), 2); !old.Equal(SomeOf(1)) || cm.Size() != 1 {
+		t.Errorf("expect Some(1) and 1 key, got %v and %v", old, cm)
+	}
+	if v := cm.Lookup(Tuple2Of(1, "a")); !v.Equal(SomeOf(2)) {
+		t.Errorf("expect Some(2), got %v", v)
+	}
+	if v := cm.GetOrCompute(Tuple2Of(1, "a"), func() int { return 3 }); v != 2 {
+		t.Errorf("expect 2, got %v", v)
+	}
+	if old := cm.Remove(Tuple2Of(1, "a")); !old.Equal(SomeOf(2)) || cm.Size() != 0 {
+		t.Errorf("expect Some(2) and no keys, got %v and %v", old, cm)
+	}
+
+	f1 := cm.GetOrComputeFuture(Tuple2Of(2, "b"), func() int {
+		time.Sleep(50 * time.Millisecond)
+		return 4
+	})
+	f2 := cm.GetOrComputeFuture(Tuple2Of(2, "b"), func() int { return 5 })
+	if f1 != f2 {
+		t.Errorf("expect the same Future for equal keys")
+	}
+	f1.Ready(time.Second)
+	if v := cm.Lookup(Tuple2Of(2, "b")); !v.Equal(SomeOf(4)) || cm.Size() != 1 {
+		t.Errorf("expect Some(4) and 1 key, got %v and %v", v, cm)
+	}
+}
@@ -0,0 +1,198 @@
+package monadgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+)
+
+var (
+	// ErrFalse is the error of a Failure from false.
+	ErrFalse = errors.New("false")
+
+	// ErrNoSuchElement is the error from accessing an empty value, like None or an unsatisfied Filter.
+	ErrNoSuchElement = errors.New("no such element")
+
+	// ErrCanceled is the error of a canceled Future.
+	// errors.Is(ErrCanceled, context.Canceled) returns true.
+	ErrCanceled error = &contextError{msg: "canceled", err: context.Canceled}
+
+	// ErrTimeout is the error of a Task not completed in time.
+	// errors.Is(ErrTimeout, context.DeadlineExceeded) returns true.
+	ErrTimeout error = &contextError{msg: "timeout", err: context.DeadlineExceeded}
+)
+
+// contextError is a sentinel error matching an error from package context.
+type contextError struct {
+	msg string
+	err error
+}
+
+func (e *contextError) Error() string {
+	return e.msg
+}
+
+func (e *contextError) Is(target error) bool {
+	return target == e.err
+}
+
+// errorOf converts a Failure value x to error.
+// x is returned if it is an error, or ErrFalse if x is false.
+func errorOf(x interface{}) error {
+	switch v := x.(type) {
+	case error:
+		return v
+	case bool:
+		if !v {
+			return ErrFalse
+		}
+	}
+
+	return fmt.Errorf("%v", x)
+}
+
+// ----------------------------------------------------------------------------
+
+// Errors represents multiple errors.
+type Errors []error
+
+func (e Errors) Error() string {
+	sb := new(strings.Builder)
+	for i, err := range e {
+		if i > 0 {
+			sb.WriteString("; ")
+		}
+		sb.WriteString(err.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns all errors in e.
+func (e Errors) Unwrap() []error {
+	return e
+}
+
+// Is reports whether any error in e matches target.
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error in e that matches target, and if so, sets target to that error value and returns true.
+func (e Errors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendErrors appends errors in x to e, and flattens x if x is Errors.
+func appendErrors(e Errors, x error) Errors {
+	if errs, ok := x.(Errors); ok {
+		return append(e, errs...)
+	}
+	return append(e, x)
+}
+
+// ----------------------------------------------------------------------------
+
+// ErrorMode decides how error-aware operations handle Failures.
+type ErrorMode int
+
+const (
+	// FailFast stops at the first Failure.
+	FailFast ErrorMode = iota
+
+	// CollectErrors applies to all elements, and collects all Failures into Errors.
+	CollectErrors
+)
+
+func errorModeOf(mode []ErrorMode) ErrorMode {
+	if len(mode) > 0 {
+		return mode[0]
+	}
+	return FailFast
+}
+
+// ----------------------------------------------------------------------------
+
+// SuppressedError represents an error with other errors suppressed while handling it,
+// like suppressed exceptions in Java.
+type SuppressedError struct {
+	Err        error
+	Suppressed Errors
+}
+
+func (e *SuppressedError) Error() string {
+	return fmt.Sprintf("%v (suppressed: %v)", e.Err, e.Suppressed)
+}
+
+// Unwrap returns the primary error.
+func (e *SuppressedError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the primary error or any suppressed error matches target.
+func (e *SuppressedError) Is(target error) bool {
+	return errors.Is(e.Err, target) || e.Suppressed.Is(target)
+}
+
+// As finds the first error in the primary error and suppressed errors that matches target.
+func (e *SuppressedError) As(target interface{}) bool {
+	return errors.As(e.Err, target) || e.Suppressed.As(target)
+}
+
+// ----------------------------------------------------------------------------
+
+// PanicError represents a panic recovered from functions invoked by Try, Future and Task.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value interface{}
+
+	// Stack is the stack trace of the goroutine where the panic occurs.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%v", e.Value)
+}
+
+// Unwrap returns Value if it is an error, otherwise return nil.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// panicFailure returns Failure of PanicError with recovered value r.
+// It must be invoked in the deferred function recovering r to capture the stack.
+func panicFailure(r interface{}) Try {
+	if err, ok := r.(*PanicError); ok {
+		return newTraitTry(false, err)
+	}
+
+	return newTraitTry(false, &PanicError{
+		Value: r,
+		Stack: debug.Stack(),
+	})
+}
+
+// safeTry returns result of f, or Failure of PanicError if f panics.
+func safeTry(f func() Try) (ret Try) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = panicFailure(r)
+		}
+	}()
+
+	return f()
+}
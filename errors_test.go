@@ -0,0 +1,190 @@
+package monadgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func ExampleErrors() {
+	_, err := os.Open("/not/exist")
+	v := Map2Validated(InvalidOf(err), InvalidOf(fmt.Errorf("e1")), nil)
+
+	var perr *os.PathError
+	fmt.Println(errors.Is(v.Errors(), os.ErrNotExist))
+	fmt.Println(errors.As(v.Errors(), &perr), perr.Path)
+	fmt.Println(errors.Is(v.ToTry().Get().(error), os.ErrNotExist))
+
+	// Output:
+	// true
+	// true /not/exist
+	// true
+}
+
+func ExamplePanicError() {
+	errBoom := errors.New("boom")
+
+	t := TryOf(1, nil).Map(func(x int) int {
+		panic(errBoom)
+	})
+
+	err := t.Get().(*PanicError)
+	fmt.Println(t)
+	fmt.Println(errors.Is(err, errBoom))
+
+	t = TryOf(1, nil).FlatMap(func(x int) Try {
+		panic("flatMap")
+	})
+	fmt.Println(t)
+
+	// Output:
+	// Failure(boom)
+	// true
+	// Failure(flatMap)
+}
+
+func TestPanicError_Future(t *testing.T) {
+	f := FutureOf(func() int {
+		return 1
+	}).Map(func(x int) int {
+		panic("map")
+	})
+
+	f.Ready(wait)
+	v, ok := futureOf(f).result()
+	if !ok || v.OK() {
+		t.Fatalf("expect Failure, but %v", v)
+	}
+
+	err, ok := v.Get().(*PanicError)
+	if !ok || err.Value != "map" {
+		t.Fatalf("expect PanicError(map), but %v", v.Get())
+	}
+
+	if !strings.Contains(string(err.Stack), "TestPanicError_Future") {
+		t.Errorf("expect stack trace containing TestPanicError_Future, but %s", err.Stack)
+	}
+
+	if err.Unwrap() != nil {
+		t.Errorf("expect nil, but %v", err.Unwrap())
+	}
+
+	g := FutureOf(func() int {
+		return 1
+	}).FlatMap(func(x int) Future {
+		panic("flatMap")
+	})
+
+	g.Ready(wait)
+	if v, ok := futureOf(g).result(); !ok || v.OK() || v.Get().(*PanicError).Value != "flatMap" {
+		t.Errorf("expect Failure(flatMap), but %v", v)
+	}
+}
+
+func TestPanicError_OnComplete(t *testing.T) {
+	p := DefaultPromise(context.Background())
+	done := make(chan Try, 2)
+
+	p.OnComplete(func(Try) {
+		panic("callback")
+	})
+	p.OnComplete(func(v Try) {
+		done <- v
+	})
+
+	p.Complete(SuccessOf(1))
+
+	select {
+	case v := <-done:
+		if v.Get() != 1 {
+			t.Errorf("expect 1, but %v", v)
+		}
+	case <-time.After(wait):
+		t.Fatal("callback after a panicking callback is not invoked")
+	}
+
+	p.OnComplete(func(Try) {
+		panic("completed")
+	})
+	p.OnComplete(func(v Try) {
+		done <- v
+	})
+
+	if v := <-done; v.Get() != 1 {
+		t.Errorf("expect 1, but %v", v)
+	}
+}
+
+func ExampleTry_ToGo() {
+	fmt.Println(TryOf(1, nil).ToGo())
+	fmt.Println(TryOf(false).ToGo())
+
+	_, err := TryOf(false).ToGo()
+	fmt.Println(errors.Is(err, ErrFalse))
+
+	fmt.Println(RightOf(1).ToGo())
+	fmt.Println(LeftOf("bad input").ToGo())
+
+	_, err = None.ToTry(nil).ToGo()
+	fmt.Println(errors.Is(err, ErrNoSuchElement))
+
+	// Output:
+	// 1 <nil>
+	// <nil> false
+	// true
+	// 1 <nil>
+	// <nil> bad input
+	// true
+}
+
+func TestPanicError_Try(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	v := SuccessOf(1).Fold(func(err error) string {
+		return "failed: " + err.Error()
+	}, func(x int) string {
+		panic(errBoom)
+	})
+	if v != "failed: boom" {
+		t.Errorf("expect failed: boom, but %v", v)
+	}
+
+	r := FailureOf(errors.New("e1")).OrElse(func() Try {
+		panic(errBoom)
+	})
+	if perr, ok := r.Get().(*PanicError); r.OK() || !ok || !errors.Is(perr, errBoom) {
+		t.Errorf("expect Failure of PanicError, but %v", r)
+	}
+
+	func() {
+		defer func() {
+			if p := recover(); p != errBoom {
+				t.Errorf("expect panic of boom, but %v", p)
+			}
+		}()
+		SuccessOf(1).Foreach(func(x int) {
+			panic(errBoom)
+		})
+	}()
+}
+
+func TestErrCanceled(t *testing.T) {
+	f := FutureOf(func() int {
+		sleep(1)
+		return 1
+	}).Map(func(x int) int {
+		return x + 1
+	})
+	f.Cancel()
+	f.Ready(wait)
+
+	v, _ := futureOf(f).result()
+	_, err := v.ToGo()
+	if !errors.Is(err, ErrCanceled) || !errors.Is(err, context.Canceled) {
+		t.Errorf("expect %v, but %v", ErrCanceled, err)
+	}
+}
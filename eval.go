@@ -0,0 +1,246 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Eval represents Eval in Cats, controlling evaluation of a value.
+// Eval is trampolined, and Map and FlatMap chains are evaluated in constant stack space.
+type Eval interface {
+	fmt.Stringer
+
+	// Value evaluates this and returns the value.
+	Value() interface{}
+
+	// Map applies f to the value.
+	// f: func(A) B
+	// returns Eval[B]
+	Map(f interface{}) Eval
+
+	// FlatMap binds the function f across the value.
+	// f: func(A) Eval
+	FlatMap(f interface{}) Eval
+
+	// Memoize returns an Eval computing value of this at most once.
+	Memoize() Eval
+}
+
+// evalNow is an Eval with a computed value.
+type evalNow struct {
+	v reflect.Value
+}
+
+// evalLater is an Eval computed at most once.
+type evalLater struct {
+	l *lazy
+}
+
+// evalAlways is an Eval computed every time.
+type evalAlways struct {
+	f func() reflect.Value
+}
+
+// evalDefer is an Eval producing another Eval.
+type evalDefer struct {
+	f func() Eval
+}
+
+// evalFlatMap is an Eval binding f across the value of src.
+type evalFlatMap struct {
+	src Eval
+	f   func(reflect.Value) Eval
+}
+
+// evaluate evaluates e with a loop and an explicit stack of continuations.
+func evaluate(e Eval) reflect.Value {
+	var stack []func(reflect.Value) Eval
+
+	for {
+		var v reflect.Value
+
+		switch x := e.(type) {
+		case *evalFlatMap:
+			stack = append(stack, x.f)
+			e = x.src
+			continue
+		case *evalDefer:
+			e = x.f()
+			continue
+		case *evalNow:
+			v = x.v
+		case *evalLater:
+			v = x.l.rv()
+		case *evalAlways:
+			v = x.f()
+		}
+
+		if len(stack) == 0 {
+			return v
+		}
+
+		f := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		e = f(v)
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+func evalValue(e Eval) interface{} {
+	return evaluate(e).Interface()
+}
+
+func evalMap(e Eval, f interface{}) Eval {
+	fw := funcOf(f)
+	return &evalFlatMap{e, func(v reflect.Value) Eval {
+		return &evalNow{fw.call(v)}
+	}}
+}
+
+func evalFlatMapOf(e Eval, f interface{}) Eval {
+	fw := funcOf(f)
+	return &evalFlatMap{e, func(v reflect.Value) Eval {
+		return fw.call(v).Interface().(Eval)
+	}}
+}
+
+func evalMemoize(e Eval) Eval {
+	return &evalLater{newLazy(func() reflect.Value {
+		return evaluate(e)
+	})}
+}
+
+// ----------------------------------------------------------------------------
+
+func (e *evalNow) String() string {
+	return fmt.Sprintf("Now(%v)", e.Value())
+}
+
+func (e *evalNow) Value() interface{} {
+	return e.v.Interface()
+}
+
+func (e *evalNow) Map(f interface{}) Eval {
+	return evalMap(e, f)
+}
+
+func (e *evalNow) FlatMap(f interface{}) Eval {
+	return evalFlatMapOf(e, f)
+}
+
+func (e *evalNow) Memoize() Eval {
+	return e
+}
+
+func (e *evalLater) String() string {
+	return "Later(?)"
+}
+
+func (e *evalLater) Value() interface{} {
+	return e.l.Get()
+}
+
+func (e *evalLater) Map(f interface{}) Eval {
+	return evalMap(e, f)
+}
+
+func (e *evalLater) FlatMap(f interface{}) Eval {
+	return evalFlatMapOf(e, f)
+}
+
+func (e *evalLater) Memoize() Eval {
+	return e
+}
+
+func (e *evalAlways) String() string {
+	return "Always(?)"
+}
+
+func (e *evalAlways) Value() interface{} {
+	return evalValue(e)
+}
+
+func (e *evalAlways) Map(f interface{}) Eval {
+	return evalMap(e, f)
+}
+
+func (e *evalAlways) FlatMap(f interface{}) Eval {
+	return evalFlatMapOf(e, f)
+}
+
+func (e *evalAlways) Memoize() Eval {
+	return evalMemoize(e)
+}
+
+func (e *evalDefer) String() string {
+	return "Defer(?)"
+}
+
+func (e *evalDefer) Value() interface{} {
+	return evalValue(e)
+}
+
+func (e *evalDefer) Map(f interface{}) Eval {
+	return evalMap(e, f)
+}
+
+func (e *evalDefer) FlatMap(f interface{}) Eval {
+	return evalFlatMapOf(e, f)
+}
+
+func (e *evalDefer) Memoize() Eval {
+	return evalMemoize(e)
+}
+
+func (e *evalFlatMap) String() string {
+	return "FlatMap(?)"
+}
+
+func (e *evalFlatMap) Value() interface{} {
+	return evalValue(e)
+}
+
+func (e *evalFlatMap) Map(f interface{}) Eval {
+	return evalMap(e, f)
+}
+
+func (e *evalFlatMap) FlatMap(f interface{}) Eval {
+	return evalFlatMapOf(e, f)
+}
+
+func (e *evalFlatMap) Memoize() Eval {
+	return evalMemoize(e)
+}
+
+// ----------------------------------------------------------------------------
+
+// EvalNow returns an Eval with value x computed eagerly.
+func EvalNow(x interface{}) Eval {
+	return &evalNow{valueOf(x)}
+}
+
+// EvalLater returns an Eval computing value with f lazily and at most once.
+// f: func() T
+func EvalLater(f interface{}) Eval {
+	return &evalLater{LazyOf(f).(*lazy)}
+}
+
+// EvalAlways returns an Eval computing value with f every time.
+// f: func() T
+func EvalAlways(f interface{}) Eval {
+	fw := funcOf(f)
+	return &evalAlways{func() reflect.Value {
+		return fw.call(unitValue)
+	}}
+}
+
+// EvalDefer returns an Eval produced by f lazily.
+// It is useful to write stack-safe recursive functions.
+// f: func() Eval
+func EvalDefer(f interface{}) Eval {
+	fw := funcOf(f)
+	return &evalDefer{func() Eval {
+		return fw.call(unitValue).Interface().(Eval)
+	}}
+}
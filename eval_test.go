@@ -0,0 +1,93 @@
+package monadgo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleEvalNow() {
+	count := 0
+	later := EvalLater(func() int {
+		count++
+		return count
+	})
+	always := EvalAlways(func() int {
+		count++
+		return count
+	})
+
+	fmt.Println(EvalNow(1).Map(func(x int) int { return x + 1 }).Value())
+	fmt.Println(later.Value(), later.Value())
+	fmt.Println(always.Value(), always.Value())
+
+	memo := always.Memoize()
+	fmt.Println(memo.Value(), memo.Value())
+
+	// Output:
+	// 2
+	// 1 1
+	// 2 3
+	// 4 4
+}
+
+func ExampleEvalDefer() {
+	var even, odd func(n int) Eval
+
+	even = func(n int) Eval {
+		return EvalDefer(func() Eval {
+			if n == 0 {
+				return EvalNow(true)
+			}
+			return odd(n - 1)
+		})
+	}
+
+	odd = func(n int) Eval {
+		return EvalDefer(func() Eval {
+			if n == 0 {
+				return EvalNow(false)
+			}
+			return even(n - 1)
+		})
+	}
+
+	fmt.Println(even(100001).Value())
+
+	// Output:
+	// false
+}
+
+func TestEval_StackSafe(t *testing.T) {
+	const n = 100000
+
+	// left-nested Map chain
+	e := EvalNow(0)
+	for i := 0; i < n; i++ {
+		e = e.Map(func(x int) int {
+			return x + 1
+		})
+	}
+
+	if x := e.Value(); x != n {
+		t.Errorf("expect %d, but %v", n, x)
+	}
+
+	// right-nested recursive FlatMap over Slice
+	s := SliceOf(make([]int, n)).Map(func(int) int { return 1 }).(Slice)
+
+	var sum func(i int) Eval
+	sum = func(i int) Eval {
+		if i >= s.Len() {
+			return EvalNow(0)
+		}
+		return EvalDefer(func() Eval {
+			return sum(i + 1)
+		}).Map(func(x int) int {
+			return x + s.Get().([]int)[i]
+		})
+	}
+
+	if x := sum(0).Value(); x != n {
+		t.Errorf("expect %d, but %v", n, x)
+	}
+}
@@ -0,0 +1,258 @@
+package monadgo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// errGuard is the failure of Try and Future if a guard does not hold.
+// errors.Is(errGuard, ErrNoSuchElement) returns true, like Try.Filter.
+var errGuard = fmt.Errorf("%w: guard does not hold", ErrNoSuchElement)
+
+// ForComprehension represents for-comprehension in Scala.
+// Generators and guards are desugared to FlatMap, and receive all values bound before them.
+// Functions with n inputs receive the first n bound values through Tuple binding.
+type ForComprehension interface {
+	// For adds a generator.
+	// x: Option, Try, Either, Future, Slice, Go slice,
+	// or func(bound values...) returning one of them with the same kind of the first generator.
+	For(x interface{}) ForComprehension
+
+	// If adds a guard.
+	// Options become None, Slices become empty, Try and Future become Failure of ErrNoSuchElement,
+	// and Either becomes Left(false) if the guard does not hold.
+	// f: func(bound values...) bool
+	If(f interface{}) ForComprehension
+
+	// Yield applies f to bound values.
+	// f: func(bound values...) R
+	// returns Option, Try, Either, Future, or Slice with the same kind of the first generator.
+	Yield(f interface{}) interface{}
+}
+
+type forStep struct {
+	guard bool
+	x     interface{}
+}
+
+type forComprehension struct {
+	steps []forStep
+}
+
+var _ ForComprehension = &forComprehension{}
+
+func (c *forComprehension) add(step forStep) *forComprehension {
+	steps := make([]forStep, len(c.steps), len(c.steps)+1)
+	copy(steps, c.steps)
+
+	return &forComprehension{
+		steps: append(steps, step),
+	}
+}
+
+func (c *forComprehension) For(x interface{}) ForComprehension {
+	return c.add(forStep{x: x})
+}
+
+func (c *forComprehension) If(f interface{}) ForComprehension {
+	if len(c.steps) == 0 {
+		panic("For must start with a generator")
+	}
+	return c.add(forStep{guard: true, x: f})
+}
+
+func (c *forComprehension) Yield(f interface{}) interface{} {
+	if len(c.steps) == 0 {
+		panic("For must start with a generator")
+	}
+
+	k := forKindOf(c.steps[0].x)
+	return c.run(k, 0, nil, f)
+}
+
+// run binds values of generator at i, and returns the container of the rest steps.
+func (c *forComprehension) run(k *forKind, i int, bound []interface{}, yield interface{}) interface{} {
+	m := k.of(c.generate(c.steps[i].x, bound))
+
+	next := i + 1
+	for next < len(c.steps) && c.steps[next].guard {
+		next++
+	}
+
+	return k.flatMap(m, func(x interface{}) interface{} {
+		b := append(bound[:len(bound):len(bound)], x)
+
+		for j := i + 1; j < next; j++ {
+			if !callBound(c.steps[j].x, b).Bool() {
+				return k.empty()
+			}
+		}
+
+		if next == len(c.steps) {
+			return k.pure(callBound(yield, b).Interface())
+		}
+
+		return c.run(k, next, b, yield)
+	})
+}
+
+// generate returns x, or the result of x with bound values if x is a function.
+func (c *forComprehension) generate(x interface{}, bound []interface{}) interface{} {
+	if reflect.TypeOf(x).Kind() == reflect.Func {
+		return callBound(x, bound).Interface()
+	}
+	return x
+}
+
+// callBound invokes f with first n bound values, n is the number of inputs of f.
+func callBound(f interface{}, bound []interface{}) reflect.Value {
+	fw := funcOf(f)
+
+	switch n := reflect.TypeOf(f).NumIn(); n {
+	case 0:
+		return fw.call(unitValue)
+	case 1:
+		return fw.call(valueOf(bound[0]))
+	default:
+		return fw.call(reflect.ValueOf(TupleOf(bound[:n])))
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// forKind is the set of operations for-comprehension needs from a kind of container.
+type forKind struct {
+	of      func(m interface{}) interface{}
+	flatMap func(m interface{}, f func(interface{}) interface{}) interface{}
+	pure    func(x interface{}) interface{}
+	empty   func() interface{}
+}
+
+var (
+	forOption = &forKind{
+		of: func(m interface{}) interface{} {
+			return m.(Option)
+		},
+		flatMap: func(m interface{}, f func(interface{}) interface{}) interface{} {
+			return m.(Option).FlatMap(func(x interface{}) Option {
+				return f(x).(Option)
+			})
+		},
+		pure: func(x interface{}) interface{} {
+			return SomeOf(x)
+		},
+		empty: func() interface{} {
+			return None
+		},
+	}
+
+	forTry = &forKind{
+		of: func(m interface{}) interface{} {
+			return m.(Try)
+		},
+		flatMap: func(m interface{}, f func(interface{}) interface{}) interface{} {
+			return m.(Try).FlatMap(func(x interface{}) Try {
+				return f(x).(Try)
+			})
+		},
+		pure: func(x interface{}) interface{} {
+			return SuccessOf(x)
+		},
+		empty: func() interface{} {
+			return FailureOf(errGuard)
+		},
+	}
+
+	forEither = &forKind{
+		of: func(m interface{}) interface{} {
+			return m.(Either)
+		},
+		flatMap: func(m interface{}, f func(interface{}) interface{}) interface{} {
+			return m.(Either).FlatMap(func(x interface{}) Either {
+				return f(x).(Either)
+			})
+		},
+		pure: func(x interface{}) interface{} {
+			return RightOf(x)
+		},
+		empty: func() interface{} {
+			return LeftOf(false)
+		},
+	}
+
+	forFuture = &forKind{
+		of: func(m interface{}) interface{} {
+			return m.(Future)
+		},
+		flatMap: func(m interface{}, f func(interface{}) interface{}) interface{} {
+			return m.(Future).FlatMap(func(x interface{}) Future {
+				return f(x).(Future)
+			})
+		},
+		pure: func(x interface{}) interface{} {
+			return DefaultPromise(context.Background()).Complete(SuccessOf(x))
+		},
+		empty: func() interface{} {
+			return DefaultPromise(context.Background()).Complete(FailureOf(errGuard))
+		},
+	}
+
+	forSlice = &forKind{
+		of: func(m interface{}) interface{} {
+			return seqOf(m)
+		},
+		flatMap: func(m interface{}, f func(interface{}) interface{}) interface{} {
+			var ret reflect.Value
+
+			s := m.(seq)
+			for i := 0; i < s.len; i++ {
+				r := f(s.v.Index(i).Interface()).(seq)
+				for j := 0; j < r.len; j++ {
+					ret = widenAppendSlice(ret, r.v.Index(j))
+				}
+			}
+
+			return seqOf(ret)
+		},
+		pure: func(x interface{}) interface{} {
+			return seqOf(oneToSlice(valueOf(x)))
+		},
+		empty: func() interface{} {
+			return emptySeq
+		},
+	}
+)
+
+// forKindOf returns the kind of container m.
+func forKindOf(m interface{}) *forKind {
+	switch m.(type) {
+	case Option:
+		return forOption
+	case Try:
+		return forTry
+	case Either:
+		return forEither
+	case Future:
+		return forFuture
+	}
+
+	if reflect.TypeOf(m).Kind() == reflect.Func {
+		panic("the first generator of For must not be a function")
+	}
+
+	return forSlice
+}
+
+// ----------------------------------------------------------------------------
+
+// For returns a ForComprehension with generators x.
+// x: Option, Try, Either, Future, Slice, Go slice,
+// or func(bound values...) returning one of them with the same kind of the first generator.
+func For(x ...interface{}) ForComprehension {
+	ret := &forComprehension{}
+	for _, gen := range x {
+		ret.steps = append(ret.steps, forStep{x: gen})
+	}
+	return ret
+}
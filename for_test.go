@@ -0,0 +1,132 @@
+package monadgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleFor() {
+	opt := For(SomeOf(1), SomeOf(2)).
+		For(func(a, b int) Option {
+			return SomeOf(a + b)
+		}).
+		Yield(func(a, b, c int) string {
+			return fmt.Sprintf("%d+%d=%d", a, b, c)
+		})
+	fmt.Println(opt)
+
+	fmt.Println(For(SomeOf(1), None).Yield(func(a, b int) int {
+		return a + b
+	}))
+
+	t := For(TryOf(10, nil)).
+		For(func(a int) Try {
+			return TryOf(a/2, nil)
+		}).
+		Yield(func(a, b int) int {
+			return a + b
+		})
+	fmt.Println(t)
+
+	fmt.Println(For(RightOf(1), LeftOf("oops")).Yield(func(a, b int) int {
+		return a + b
+	}))
+
+	// Output:
+	// Some(1+2=3)
+	// None
+	// Success(15)
+	// Left(oops)
+}
+
+func ExampleForComprehension_If() {
+	pairs := For([]int{1, 2, 3}).
+		For(func(a int) []int {
+			return []int{a, a * 10}
+		}).
+		If(func(a, b int) bool {
+			return a != b
+		}).
+		Yield(func(a, b int) int {
+			return a + b
+		})
+	fmt.Println(pairs)
+
+	fmt.Println(For(SomeOf(1)).If(func(a int) bool {
+		return a > 1
+	}).Yield(func(a int) int {
+		return a
+	}))
+
+	fmt.Println(For(TryOf(1, nil)).If(func(a int) bool {
+		return a > 1
+	}).Yield(func(a int) int {
+		return a
+	}))
+
+	// Output:
+	// [11 22 33]
+	// None
+	// Failure(no such element: guard does not hold)
+}
+
+func TestFor_Future(t *testing.T) {
+	f1 := FutureOf(func() int {
+		return 1000
+	})
+
+	f2 := FutureOf(func() int {
+		return 2000
+	})
+
+	f := For(f1, f2).If(func(a, b int) bool {
+		return a < b
+	}).Yield(func(a, b int) int {
+		return a + b
+	}).(Future)
+
+	f.Ready(wait)
+	if v := f.Value().Get(); v != 3000 {
+		t.Errorf("expect 3000, but %v", v)
+	}
+
+	errFailed := errors.New("failed")
+	f = For(f1).For(func(a int) Future {
+		return FutureOf(func() (int, error) {
+			return 0, errFailed
+		})
+	}).Yield(func(a, b int) int {
+		return a + b
+	}).(Future)
+
+	f.Ready(wait)
+
+	if v, ok := futureOf(f).result(); !ok || v.OK() || v.Get() != errFailed {
+		t.Errorf("expect Failure(%v), but %v", errFailed, v)
+	}
+}
+
+func TestFor_GuardFailure(t *testing.T) {
+	gt := func(a int) bool {
+		return a > 1
+	}
+	id := func(a int) int {
+		return a
+	}
+
+	v := For(SuccessOf(1)).If(gt).Yield(id).(Try)
+	if v.OK() || !errors.Is(v.Get().(error), ErrNoSuchElement) {
+		t.Errorf("expect Failure of ErrNoSuchElement, but %v", v)
+	}
+
+	f := For(FutureOf(func() int { return 1 })).If(gt).Yield(id).(Future)
+	f.Ready(wait)
+	if v, ok := futureOf(f).result(); !ok || v.OK() || !errors.Is(v.Get().(error), ErrNoSuchElement) {
+		t.Errorf("expect Failure of ErrNoSuchElement, but %v", v)
+	}
+
+	if e := For(RightOf(1)).If(gt).Yield(id).(Either); !e.IsLeft() || e.Get() != false {
+		t.Errorf("expect Left(false), but %v", e)
+	}
+}
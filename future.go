@@ -9,7 +9,7 @@ import (
 )
 
 // cancelFailure is a Failure value for canceling a future.
-var cancelFailure = FailureOf(fmt.Errorf("user cancel"))
+var cancelFailure = FailureOf(ErrCanceled)
 
 // Future represents scala-like Future.
 type Future interface {
@@ -22,6 +22,7 @@ type Future interface {
 	Completed() bool
 
 	// OnComplete adds a callback function invoked when future is completed.
+	// Panics in callbacks are recovered and discarded, and do not affect other callbacks.
 	OnComplete(func(Try))
 
 	// Map applies the function to successful future.
@@ -70,6 +71,17 @@ type Future interface {
 	// Result waits at most duration and returns Some of value if future is completed successfully, otherwise return None.
 	Result(atMost time.Duration) Option
 
+	// Zip returns a future with Tuple2 of values of this and that,
+	// or fails as soon as any of this and that fails.
+	// this and that are waited concurrently.
+	Zip(that Future) Future
+
+	// ZipWith returns a future with result from applying f to values of this and that,
+	// or fails as soon as any of this and that fails.
+	// this and that are waited concurrently.
+	// f: func(T, U) X
+	ZipWith(that Future, f interface{}) Future
+
 	// Cancel cancels the future if it is not completed.
 	// Can not cancel a completed future.
 	Cancel()
@@ -80,6 +92,7 @@ type Future interface {
 type future struct {
 	completed bool
 	in        chan Try
+	done      chan struct{}
 	ctx       context.Context
 	cancel    context.CancelFunc
 	val       Try
@@ -89,32 +102,67 @@ type future struct {
 
 var _ Future = &future{}
 
-func (u *future) register(f func(Try)) {
+// register adds f to callbacks if u is not completed,
+// and returns the result and true if u is completed.
+func (u *future) register(f func(Try)) (Try, bool) {
 	defer u.mux.Unlock()
 	u.mux.Lock()
 
+	if u.completed {
+		return u.val, true
+	}
+
 	u.next = append(u.next, f)
+	return nil, false
+}
+
+// futureOf returns the internal future of u.
+// Other implementations of Future are adapted by a Promise completed with OnComplete.
+func futureOf(u Future) *future {
+	switch v := u.(type) {
+	case *future:
+		return v
+	case *Promise:
+		return v.future
+	}
+
+	return DefaultPromise(context.Background()).CompleteWith(u).future
+}
+
+// result returns the result and true if u is completed.
+// result is cancelFailure if u is canceled.
+func (u *future) result() (Try, bool) {
+	defer u.mux.Unlock()
+	u.mux.Lock()
+
+	return u.val, u.completed
 }
 
 func (u *future) String() string {
-	if u.completed {
-		return fmt.Sprintf("Future(%v)", u.val)
+	if v, ok := u.result(); ok {
+		return fmt.Sprintf("Future(%v)", v)
 	}
 
 	return "Future(Not Yet)"
 }
 
 func (u *future) Completed() bool {
-	return u.completed
+	_, ok := u.result()
+	return ok
 }
 
 func (u *future) OnComplete(f func(Try)) {
-	if u.completed {
-		f(u.val)
-		return
+	if v, ok := u.register(f); ok {
+		runCallback(f, v)
 	}
+}
 
-	u.register(f)
+// runCallback invokes callback f with v, and recovers panics in f.
+func runCallback(f func(Try), v Try) {
+	safeTry(func() Try {
+		f(v)
+		return successUnit
+	})
 }
 
 func (u *future) transform(f func(Try) Try) Future {
@@ -130,7 +178,15 @@ func (u *future) transformWith(f func(Try) Future) Future {
 	e := DefaultPromise(u.ctx)
 
 	u.OnComplete(func(v Try) {
-		n := f(v)
+		var n Future
+		if failure := safeTry(func() Try {
+			n = f(v)
+			return successUnit
+		}); failure.Failed() {
+			e.Complete(failure)
+			return
+		}
+
 		if n != u {
 			e.CompleteWith(n)
 		} else {
@@ -142,8 +198,8 @@ func (u *future) transformWith(f func(Try) Future) Future {
 }
 
 func (u *future) Value() Option {
-	if u.Completed() {
-		return u.val.ToOption()
+	if v, ok := u.result(); ok {
+		return v.ToOption()
 	}
 	return None
 }
@@ -153,7 +209,7 @@ func (u *future) Foreach(f interface{}) {
 }
 
 func (u *future) Map(f interface{}) Future {
-	if u.completed && u.val.Failed() {
+	if v, ok := u.result(); ok && v.Failed() {
 		return u
 	}
 	ft := func(v Try) Try {
@@ -168,7 +224,7 @@ func (u *future) Map(f interface{}) Future {
 }
 
 func (u *future) Recover(f interface{}) Future {
-	if u.completed && u.val.OK() {
+	if v, ok := u.result(); ok && v.OK() {
 		return u
 	}
 
@@ -184,7 +240,7 @@ func (u *future) Recover(f interface{}) Future {
 }
 
 func (u *future) FlatMap(f interface{}) Future {
-	if u.completed && u.val.Failed() {
+	if v, ok := u.result(); ok && v.Failed() {
 		return u
 	}
 
@@ -199,7 +255,7 @@ func (u *future) FlatMap(f interface{}) Future {
 }
 
 func (u *future) RecoverWith(f interface{}) Future {
-	if u.completed && u.val.OK() {
+	if v, ok := u.result(); ok && v.OK() {
 		return u
 	}
 
@@ -214,21 +270,15 @@ func (u *future) RecoverWith(f interface{}) Future {
 }
 
 func (u *future) Ready(atMost time.Duration) Option {
-	if u.completed {
+	if u.Completed() {
 		return SomeOf(u)
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), atMost)
 	defer cancel()
-	done := make(chan bool, 1)
-	defer close(done)
-
-	u.OnComplete(func(Try) {
-		done <- true
-	})
 
 	select {
-	case <-done:
+	case <-u.done:
 		return SomeOf(u)
 	case <-ctx.Done():
 		return None
@@ -249,7 +299,7 @@ func (u *future) Filter(f interface{}) Future {
 }
 
 func (u *future) Collect(pf PartialFunc) Future {
-	if u.completed && u.val.Failed() {
+	if v, ok := u.result(); ok && v.Failed() {
 		return u
 	}
 
@@ -269,8 +319,16 @@ func (u *future) Collect(pf PartialFunc) Future {
 	return u.transform(ft)
 }
 
+func (u *future) Zip(that Future) Future {
+	return mapNFuture(nil, u, that)
+}
+
+func (u *future) ZipWith(that Future, f interface{}) Future {
+	return mapNFuture(f, u, that)
+}
+
 func (u *future) Cancel() {
-	if !u.completed {
+	if !u.Completed() {
 		u.cancel()
 	}
 }
@@ -281,6 +339,7 @@ func initFuture(ctx context.Context) *future {
 	ret := &future{mux: &sync.Mutex{}}
 	ret.ctx, ret.cancel = context.WithCancel(ctx)
 	ret.in = make(chan Try)
+	ret.done = make(chan struct{})
 	return ret
 }
 
@@ -288,37 +347,33 @@ func newFuture(ctx context.Context, f func(Try) Try) *future {
 	ret := initFuture(ctx)
 
 	go func() {
-		defer func() {
-			close(ret.in)
-		}()
-
 		select {
 		case <-ret.ctx.Done():
+			ret.mux.Lock()
 			ret.val = cancelFailure
 			ret.completed = true
-			defer ret.mux.Unlock()
-			ret.mux.Lock()
 			ret.next = nil
-			return
-		case x, ok := <-ret.in:
-			if !ok {
-				return
-			}
+			ret.mux.Unlock()
+			close(ret.done)
+		case x := <-ret.in:
 			if f != nil {
-				ret.val = f(x)
-			} else {
-				ret.val = x
+				in := x
+				x = safeTry(func() Try {
+					return f(in)
+				})
 			}
 
-			ret.completed = true
-
-			defer ret.mux.Unlock()
 			ret.mux.Lock()
+			ret.val = x
+			ret.completed = true
+			next := ret.next
+			ret.next = nil
+			ret.mux.Unlock()
+			close(ret.done)
 
-			for _, callback := range ret.next {
-				callback(ret.val)
+			for _, callback := range next {
+				runCallback(callback, x)
 			}
-			ret.next = nil
 		}
 	}()
 
@@ -0,0 +1,239 @@
+package monadgo
+
+import (
+	"fmt"
+)
+
+// FutureOption represents OptionT[Future, T] in Cats, a Future of Option.
+// Functions applied to FutureOption see through both Future and Option.
+type FutureOption interface {
+	fmt.Stringer
+
+	// Value returns the underlying Future of Option.
+	Value() Future
+
+	// Map applies f to the value if it is Some.
+	// f: func(T) X
+	// returns FutureOption[X]
+	Map(f interface{}) FutureOption
+
+	// FlatMap binds the function f across the value if it is Some.
+	// f: func(T) FutureOption
+	FlatMap(f interface{}) FutureOption
+
+	// SubflatMap binds the function f returning Option across the value if it is Some.
+	// f: func(T) Option
+	SubflatMap(f interface{}) FutureOption
+
+	// SemiflatMap binds the function f returning Future across the value if it is Some.
+	// f: func(T) Future
+	SemiflatMap(f interface{}) FutureOption
+
+	// GetOrElse returns a Future of the value if it is Some, or z if it is None.
+	// z: func() X or value with type X
+	GetOrElse(z interface{}) Future
+}
+
+type futureOption struct {
+	u Future
+}
+
+var _ FutureOption = &futureOption{}
+
+func (fo *futureOption) String() string {
+	return fmt.Sprintf("FutureOption(%v)", fo.u)
+}
+
+func (fo *futureOption) Value() Future {
+	return fo.u
+}
+
+// flatMap binds f across the value if it is Some, or keeps Failure and None.
+func (fo *futureOption) flatMap(f func(interface{}) Future) FutureOption {
+	u := futureOf(fo.u)
+	return &futureOption{
+		u: u.transformWith(func(v Try) Future {
+			if v.Failed() {
+				return u
+			}
+
+			o := v.Get().(Option)
+			if !o.Defined() {
+				return u
+			}
+
+			return f(o.Get())
+		}),
+	}
+}
+
+func (fo *futureOption) Map(f interface{}) FutureOption {
+	return &futureOption{
+		u: fo.u.Map(func(o Option) Option {
+			return o.Map(f)
+		}),
+	}
+}
+
+func (fo *futureOption) FlatMap(f interface{}) FutureOption {
+	fw := funcOf(f)
+	return fo.flatMap(func(x interface{}) Future {
+		return fw.call(valueOf(x)).Interface().(FutureOption).Value()
+	})
+}
+
+func (fo *futureOption) SubflatMap(f interface{}) FutureOption {
+	return &futureOption{
+		u: fo.u.Map(func(o Option) Option {
+			return o.FlatMap(f)
+		}),
+	}
+}
+
+func (fo *futureOption) SemiflatMap(f interface{}) FutureOption {
+	fw := funcOf(f)
+	return fo.flatMap(func(x interface{}) Future {
+		return fw.call(valueOf(x)).Interface().(Future).Map(func(y interface{}) Option {
+			return SomeOf(y)
+		})
+	})
+}
+
+func (fo *futureOption) GetOrElse(z interface{}) Future {
+	return fo.u.Map(func(o Option) interface{} {
+		return o.GetOrElse(z)
+	})
+}
+
+// FutureOptionOf returns a FutureOption from u.
+// u: Future of Option.
+func FutureOptionOf(u Future) FutureOption {
+	return &futureOption{u: u}
+}
+
+// LiftFutureOption returns a FutureOption with Some of the value of u.
+func LiftFutureOption(u Future) FutureOption {
+	return &futureOption{
+		u: u.Map(func(x interface{}) Option {
+			return SomeOf(x)
+		}),
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// FutureEither represents EitherT[Future, L, R] in Cats, a Future of Either.
+// Functions applied to FutureEither see through both Future and Either.
+type FutureEither interface {
+	fmt.Stringer
+
+	// Value returns the underlying Future of Either.
+	Value() Future
+
+	// Map applies f to the value if it is Right.
+	// f: func(R) X
+	// returns FutureEither[L, X]
+	Map(f interface{}) FutureEither
+
+	// FlatMap binds the function f across the value if it is Right.
+	// f: func(R) FutureEither
+	FlatMap(f interface{}) FutureEither
+
+	// SubflatMap binds the function f returning Either across the value if it is Right.
+	// f: func(R) Either
+	SubflatMap(f interface{}) FutureEither
+
+	// SemiflatMap binds the function f returning Future across the value if it is Right.
+	// f: func(R) Future
+	SemiflatMap(f interface{}) FutureEither
+
+	// GetOrElse returns a Future of the value if it is Right, or z if it is Left.
+	// z: func() X or value with type X
+	GetOrElse(z interface{}) Future
+}
+
+type futureEither struct {
+	u Future
+}
+
+var _ FutureEither = &futureEither{}
+
+func (fe *futureEither) String() string {
+	return fmt.Sprintf("FutureEither(%v)", fe.u)
+}
+
+func (fe *futureEither) Value() Future {
+	return fe.u
+}
+
+// flatMap binds f across the value if it is Right, or keeps Failure and Left.
+func (fe *futureEither) flatMap(f func(interface{}) Future) FutureEither {
+	u := futureOf(fe.u)
+	return &futureEither{
+		u: u.transformWith(func(v Try) Future {
+			if v.Failed() {
+				return u
+			}
+
+			e := v.Get().(Either)
+			if e.IsLeft() {
+				return u
+			}
+
+			return f(e.Get())
+		}),
+	}
+}
+
+func (fe *futureEither) Map(f interface{}) FutureEither {
+	return &futureEither{
+		u: fe.u.Map(func(e Either) Either {
+			return e.Map(f)
+		}),
+	}
+}
+
+func (fe *futureEither) FlatMap(f interface{}) FutureEither {
+	fw := funcOf(f)
+	return fe.flatMap(func(x interface{}) Future {
+		return fw.call(valueOf(x)).Interface().(FutureEither).Value()
+	})
+}
+
+func (fe *futureEither) SubflatMap(f interface{}) FutureEither {
+	return &futureEither{
+		u: fe.u.Map(func(e Either) Either {
+			return e.FlatMap(f)
+		}),
+	}
+}
+
+func (fe *futureEither) SemiflatMap(f interface{}) FutureEither {
+	fw := funcOf(f)
+	return fe.flatMap(func(x interface{}) Future {
+		return fw.call(valueOf(x)).Interface().(Future).Map(func(y interface{}) Either {
+			return RightOf(y)
+		})
+	})
+}
+
+func (fe *futureEither) GetOrElse(z interface{}) Future {
+	return fe.u.Map(func(e Either) interface{} {
+		return e.GetOrElse(z)
+	})
+}
+
+// FutureEitherOf returns a FutureEither from u.
+// u: Future of Either.
+func FutureEitherOf(u Future) FutureEither {
+	return &futureEither{u: u}
+}
+
+// LiftFutureEither returns a FutureEither with Right of the value of u.
+func LiftFutureEither(u Future) FutureEither {
+	return &futureEither{
+		u: u.Map(func(x interface{}) Either {
+			return RightOf(x)
+		}),
+	}
+}
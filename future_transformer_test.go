@@ -0,0 +1,108 @@
+package monadgo
+
+import (
+	"errors"
+	"testing"
+)
+
+func findUser(id int) FutureOption {
+	return FutureOptionOf(FutureOf(func() Option {
+		if id == 1 {
+			return SomeOf("alice")
+		}
+		return None
+	}))
+}
+
+func TestFutureOption(t *testing.T) {
+	f := findUser(1).FlatMap(func(name string) FutureOption {
+		return LiftFutureOption(FutureOf(func() int {
+			return len(name)
+		}))
+	}).Map(func(n int) int {
+		return n * 10
+	}).GetOrElse(-1)
+
+	f.Ready(wait)
+	if x := f.Value().Get(); x != 50 {
+		t.Errorf("expect 50, but %v", x)
+	}
+
+	f = findUser(2).Map(func(name string) int {
+		return len(name)
+	}).GetOrElse(-1)
+
+	f.Ready(wait)
+	if x := f.Value().Get(); x != -1 {
+		t.Errorf("expect -1, but %v", x)
+	}
+
+	o := findUser(1).SubflatMap(func(name string) Option {
+		return None
+	}).Value()
+
+	o.Ready(wait)
+	if x, _ := futureOf(o).result(); x.Get() != None {
+		t.Errorf("expect None, but %v", x)
+	}
+
+	o = findUser(1).SemiflatMap(func(name string) Future {
+		return FutureOf(func() string {
+			return name + "!"
+		})
+	}).Value()
+
+	o.Ready(wait)
+	if x, _ := futureOf(o).result(); x.Get().(Option).Get() != "alice!" {
+		t.Errorf("expect Some(alice!), but %v", x)
+	}
+}
+
+func TestFutureEither(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	find := func(id int) FutureEither {
+		return FutureEitherOf(FutureOf(func() Either {
+			if id == 1 {
+				return RightOf(100)
+			}
+			return LeftOf(errNotFound)
+		}))
+	}
+
+	f := find(1).SemiflatMap(func(x int) Future {
+		return FutureOf(func() int {
+			return x + 1
+		})
+	}).FlatMap(func(x int) FutureEither {
+		return find(2).Map(func(y int) int {
+			return x + y
+		})
+	}).Value()
+
+	f.Ready(wait)
+	if x, ok := f.Value().Get().(Either); !ok || x.IsRight() || x.Get() != errNotFound {
+		t.Errorf("expect Left(%v), but %v", errNotFound, f.Value())
+	}
+
+	g := find(1).SubflatMap(func(x int) Either {
+		return RightOf(x * 2)
+	}).GetOrElse(0)
+
+	g.Ready(wait)
+	if x := g.Value().Get(); x != 200 {
+		t.Errorf("expect 200, but %v", x)
+	}
+
+	errFailed := errors.New("failed")
+	h := LiftFutureEither(FutureOf(func() (int, error) {
+		return 0, errFailed
+	})).Map(func(x int) int {
+		return x + 1
+	}).Value()
+
+	h.Ready(wait)
+	if x, ok := futureOf(h).result(); !ok || x.OK() || x.Get() != errFailed {
+		t.Errorf("expect Failure(%v), but %v", errFailed, x)
+	}
+}
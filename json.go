@@ -0,0 +1,472 @@
+package monadgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// JSON encodings:
+//
+//	Option:       value of Some, or null for None.
+//	Either:       {"left": value} or {"right": value}.
+//	Try:          {"success": value} or {"failure": error message}.
+//	Tuple:        array of elements, eg: [1,"a"].
+//	Pair:         {"key": key, "value": value}.
+//	Slice:        array of elements.
+//	Map:          object if key kind is string, otherwise array of Pairs.
+//
+// Option, Either, Try, Slice and Map are interfaces, and can be decoded with
+// OptionFromJSON, EitherFromJSON, TryFromJSON, SliceFromJSON and MapFromJSON with target Go types.
+// Tuples and Pair implement json.Unmarshaler, and decode elements with types of the receiver if they exist.
+// Tuples and Pairs nested in other values have no element types.
+// Elements without target types are decoded like into interface{}, eg: numbers are float64.
+
+type eitherJSON struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+type tryJSON struct {
+	Success json.RawMessage `json:"success,omitempty"`
+	Failure *string         `json:"failure,omitempty"`
+}
+
+type pairJSON struct {
+	Key   json.RawMessage `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
+
+// marshalPair encodes key k and value v to a pairJSON.
+func marshalPair(k, v interface{}) (pairJSON, error) {
+	kdata, err := json.Marshal(k)
+	if err != nil {
+		return pairJSON{}, err
+	}
+
+	vdata, err := json.Marshal(v)
+	if err != nil {
+		return pairJSON{}, err
+	}
+
+	return pairJSON{Key: kdata, Value: vdata}, nil
+}
+
+// unmarshalValue decodes data to a value with type t, or like into interface{} if t is nil.
+func unmarshalValue(data []byte, t reflect.Type) (reflect.Value, error) {
+	if t == nil {
+		t = typeInterface
+	}
+
+	ptr := reflect.New(t)
+	if err := json.Unmarshal(data, ptr.Interface()); err != nil {
+		return reflect.Value{}, err
+	}
+
+	return ptr.Elem(), nil
+}
+
+// concreteValue returns the value in v if v is an interface, or nullValue if the value is nil.
+func concreteValue(v reflect.Value) reflect.Value {
+	if v.Kind() == reflect.Interface {
+		return valueOf(v.Interface())
+	}
+	return v
+}
+
+// unmarshalTypedValues decodes JSON array data to values with types,
+// and returns types of values. types can be nil or have nil elements.
+func unmarshalTypedValues(data []byte, types []reflect.Type) ([]reflect.Type, []interface{}, error) {
+	var raws []json.RawMessage
+	if err := json.Unmarshal(data, &raws); err != nil {
+		return nil, nil, err
+	}
+
+	if types == nil {
+		types = make([]reflect.Type, len(raws))
+	}
+
+	if len(raws) != len(types) {
+		return nil, nil, fmt.Errorf("expect %d elements, got %d", len(types), len(raws))
+	}
+
+	retTypes := make([]reflect.Type, len(raws))
+	values := make([]interface{}, len(raws))
+	for i, raw := range raws {
+		v, err := unmarshalValue(raw, types[i])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		values[i] = v.Interface()
+		retTypes[i] = types[i]
+		if retTypes[i] == nil {
+			retTypes[i] = reflect.TypeOf(values[i])
+		}
+	}
+
+	return retTypes, values, nil
+}
+
+// isNullJSON reports whether data is JSON null.
+func isNullJSON(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// ----------------------------------------------------------------------------
+
+// MarshalJSON encodes Some as its value, and None as null.
+func (o *traitOption) MarshalJSON() ([]byte, error) {
+	if o.empty {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(o.Get())
+}
+
+// MarshalJSON encodes Left as {"left": value}, and Right as {"right": value}.
+func (e *traitEither) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(e.Get())
+	if err != nil {
+		return nil, err
+	}
+
+	if e.right {
+		return json.Marshal(eitherJSON{Right: data})
+	}
+	return json.Marshal(eitherJSON{Left: data})
+}
+
+// MarshalJSON encodes Success as {"success": value}, and Failure as {"failure": error message}.
+func (t *traitTry) MarshalJSON() ([]byte, error) {
+	if !t.ok {
+		msg := errorOf(t.Get()).Error()
+		return json.Marshal(tryJSON{Failure: &msg})
+	}
+
+	data, err := json.Marshal(t.Get())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(tryJSON{Success: data})
+}
+
+// MarshalJSON encodes elements as a JSON array.
+func (t TupleN) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.values)
+}
+
+// UnmarshalJSON decodes a JSON array to elements with types of t, if t is not zero.
+// It fails if t is not zero and length of the array is not the dimension of t.
+func (t *TupleN) UnmarshalJSON(data []byte) error {
+	types, values, err := unmarshalTypedValues(data, t.types)
+	if err != nil {
+		return err
+	}
+
+	ret := TupleN{
+		d:      len(types),
+		types:  types,
+		values: values,
+		vals:   make([]reflect.Value, len(types)),
+	}
+	for i, x := range values {
+		ret.vals[i] = reflect.ValueOf(x)
+	}
+
+	*t = ret
+	return nil
+}
+
+// MarshalJSON encodes elements as a JSON array.
+func (t Tuple2) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.values)
+}
+
+// UnmarshalJSON decodes a JSON array to elements with types of t, if t is not zero.
+// It fails if length of the array is not the dimension of t.
+func (t *Tuple2) UnmarshalJSON(data []byte) error {
+	types, values, err := unmarshalTypedValues(data, t.types[:])
+	if err != nil {
+		return err
+	}
+
+	*t = formTuple2(types[0], types[1], values[0], values[1])
+	return nil
+}
+
+// MarshalJSON encodes elements as a JSON array.
+func (t Tuple3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.values)
+}
+
+// UnmarshalJSON decodes a JSON array to elements with types of t, if t is not zero.
+// It fails if length of the array is not the dimension of t.
+func (t *Tuple3) UnmarshalJSON(data []byte) error {
+	types, values, err := unmarshalTypedValues(data, t.types[:])
+	if err != nil {
+		return err
+	}
+
+	*t = formTuple3(types[0], types[1], types[2], values[0], values[1], values[2])
+	return nil
+}
+
+// MarshalJSON encodes elements as a JSON array.
+func (t Tuple4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.values)
+}
+
+// UnmarshalJSON decodes a JSON array to elements with types of t, if t is not zero.
+// It fails if length of the array is not the dimension of t.
+func (t *Tuple4) UnmarshalJSON(data []byte) error {
+	types, values, err := unmarshalTypedValues(data, t.types[:])
+	if err != nil {
+		return err
+	}
+
+	*t = formTuple4(types[0], types[1], types[2], types[3], values[0], values[1], values[2], values[3])
+	return nil
+}
+
+// MarshalJSON encodes p as {"key": key, "value": value}.
+func (p Pair) MarshalJSON() ([]byte, error) {
+	pj, err := marshalPair(p.Key(), p.Value())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(pj)
+}
+
+// UnmarshalJSON decodes {"key": key, "value": value} with types of p, if p is not zero.
+func (p *Pair) UnmarshalJSON(data []byte) error {
+	var pj pairJSON
+	if err := json.Unmarshal(data, &pj); err != nil {
+		return err
+	}
+
+	k, err := unmarshalValue(pj.Key, p.types[0])
+	if err != nil {
+		return err
+	}
+
+	v, err := unmarshalValue(pj.Value, p.types[1])
+	if err != nil {
+		return err
+	}
+
+	ktype, vtype := p.types[0], p.types[1]
+	if ktype == nil {
+		ktype = reflect.TypeOf(k.Interface())
+	}
+	if vtype == nil {
+		vtype = reflect.TypeOf(v.Interface())
+	}
+
+	*p = pairFromTuple2(formTuple2(ktype, vtype, k.Interface(), v.Interface()))
+	return nil
+}
+
+// MarshalJSON encodes elements as a JSON array.
+func (s seq) MarshalJSON() ([]byte, error) {
+	if s.len <= 0 {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(s.x)
+}
+
+// MarshalJSON encodes m as an object if key kind is string,
+// otherwise as an array of Pairs sorted by encoded keys.
+func (m _map) MarshalJSON() ([]byte, error) {
+	if m.ktype.Kind() == reflect.String {
+		return json.Marshal(m.v.Interface())
+	}
+
+	pairs := make([]pairJSON, 0, m.v.Len())
+	it := m.v.MapRange()
+	for it.Next() {
+		pj, err := marshalPair(it.Key().Interface(), it.Value().Interface())
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, pj)
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		return bytes.Compare(pairs[i].Key, pairs[j].Key) < 0
+	})
+
+	return json.Marshal(pairs)
+}
+
+// MarshalJSON encodes a snapshot of m like Map.
+func (m *concurrentMap) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.Snapshot())
+}
+
+// ----------------------------------------------------------------------------
+
+// OptionFromJSON decodes data to Option. null is decoded to None, and others to Some of value with type t.
+// Values are decoded like into interface{} if t is nil.
+func OptionFromJSON(data []byte, t reflect.Type) (Option, error) {
+	if isNullJSON(data) {
+		return None, nil
+	}
+
+	v, err := unmarshalValue(data, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return &traitOption{empty: false, v: concreteValue(v)}, nil
+}
+
+// EitherFromJSON decodes {"left": value} to Left of value with type left,
+// and {"right": value} to Right of value with type right.
+func EitherFromJSON(data []byte, left, right reflect.Type) (Either, error) {
+	var ej eitherJSON
+	if err := json.Unmarshal(data, &ej); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case ej.Left != nil && ej.Right == nil:
+		v, err := unmarshalValue(ej.Left, left)
+		if err != nil {
+			return nil, err
+		}
+		return &traitEither{right: false, v: concreteValue(v)}, nil
+
+	case ej.Right != nil && ej.Left == nil:
+		v, err := unmarshalValue(ej.Right, right)
+		if err != nil {
+			return nil, err
+		}
+		return &traitEither{right: true, v: concreteValue(v)}, nil
+	}
+
+	return nil, errors.New("expect exactly one of left and right in Either")
+}
+
+// TryFromJSON decodes {"success": value} to Success of value with type t,
+// and {"failure": message} to Failure of an error with message.
+func TryFromJSON(data []byte, t reflect.Type) (Try, error) {
+	var tj tryJSON
+	if err := json.Unmarshal(data, &tj); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case tj.Failure != nil && tj.Success == nil:
+		return newTraitTry(false, errors.New(*tj.Failure)), nil
+
+	case tj.Success != nil && tj.Failure == nil:
+		v, err := unmarshalValue(tj.Success, t)
+		if err != nil {
+			return nil, err
+		}
+		return &traitTry{ok: true, v: concreteValue(v)}, nil
+	}
+
+	return nil, errors.New("expect exactly one of success and failure in Try")
+}
+
+// TupleFromJSON decodes a JSON array to Tuple with element types.
+// The dimension comes from the array if types are omitted.
+func TupleFromJSON(data []byte, types ...reflect.Type) (Tuple, error) {
+	if len(types) == 0 {
+		types = nil
+	}
+
+	types, values, err := unmarshalTypedValues(data, types)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(types) < 2 {
+		return nil, fmt.Errorf("expect at least 2 elements, got %d", len(types))
+	}
+
+	return formTuple(types, values), nil
+}
+
+// SliceFromJSON decodes a JSON array to Slice with Go slice type t, eg: reflect.TypeOf([]int(nil)).
+func SliceFromJSON(data []byte, t reflect.Type) (Slice, error) {
+	if t == nil {
+		t = reflect.SliceOf(typeInterface)
+	}
+
+	if t.Kind() != reflect.Slice {
+		return nil, fmt.Errorf("%v is not a slice", t)
+	}
+
+	v, err := unmarshalValue(data, t)
+	if err != nil {
+		return nil, err
+	}
+
+	return seqFromValue(v), nil
+}
+
+// MapFromJSON decodes an object or an array of Pairs to Map with Go map type t,
+// eg: reflect.TypeOf(map[int]string(nil)).
+func MapFromJSON(data []byte, t reflect.Type) (Map, error) {
+	if t == nil {
+		t = reflect.MapOf(reflect.TypeOf(""), typeInterface)
+	}
+
+	if t.Kind() != reflect.Map {
+		return nil, fmt.Errorf("%v is not a map", t)
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		v, err := unmarshalValue(data, t)
+		if err != nil {
+			return nil, err
+		}
+		if v.IsNil() {
+			v = reflect.MakeMap(t)
+		}
+		return newMap(v), nil
+	}
+
+	var pairs []pairJSON
+	if err := json.Unmarshal(data, &pairs); err != nil {
+		return nil, err
+	}
+
+	m := reflect.MakeMapWithSize(t, len(pairs))
+	for _, pj := range pairs {
+		k, err := unmarshalValue(pj.Key, t.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		v, err := unmarshalValue(pj.Value, t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		m.SetMapIndex(k, v)
+	}
+
+	return newMap(m), nil
+}
+
+// MarshalJSON encodes Unit as null.
+func (u _unit) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// MarshalJSON encodes Null as null.
+func (n *_null) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
+
+// MarshalJSON encodes Nothing as null.
+func (n *_nothing) MarshalJSON() ([]byte, error) {
+	return []byte("null"), nil
+}
@@ -0,0 +1,197 @@
+package monadgo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func printJSON(x interface{}) {
+	data, err := json.Marshal(x)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(data))
+}
+
+func Example_json() {
+	printJSON(SomeOf(1))
+	printJSON(None)
+	printJSON(RightOf("a"))
+	printJSON(LeftOf(1))
+	printJSON(SuccessOf(SomeOf(1)))
+	printJSON(FailureOf(errors.New("oops")))
+	printJSON(LeftOf(nil))
+	printJSON(SuccessOf())
+	printJSON(Tuple3Of(1, "a", None))
+	printJSON(PairOf("a", 1))
+	printJSON(SliceOf([]Option{SomeOf(1), None}))
+	printJSON(MapOf(map[string]int{"a": 1}))
+	printJSON(MapOf(map[int]string{2: "b", 1: "a"}))
+	printJSON(struct {
+		Name  string
+		Email Option
+	}{"a", None})
+
+	// Output:
+	// 1
+	// null
+	// {"right":"a"}
+	// {"left":1}
+	// {"success":1}
+	// {"failure":"oops"}
+	// {"left":null}
+	// {"success":null}
+	// [1,"a",null]
+	// {"key":"a","value":1}
+	// [1,null]
+	// {"a":1}
+	// [{"key":1,"value":"a"},{"key":2,"value":"b"}]
+	// {"Name":"a","Email":null}
+}
+
+func ExampleOptionFromJSON() {
+	fmt.Println(OptionFromJSON([]byte(`1`), reflect.TypeOf(0)))
+	fmt.Println(OptionFromJSON([]byte(`null`), reflect.TypeOf(0)))
+	fmt.Println(EitherFromJSON([]byte(`{"left":"oops"}`), reflect.TypeOf(""), reflect.TypeOf(0)))
+	fmt.Println(EitherFromJSON([]byte(`{"right":1}`), reflect.TypeOf(""), reflect.TypeOf(0)))
+	fmt.Println(TryFromJSON([]byte(`{"success":[1,2]}`), reflect.TypeOf([]int{})))
+	fmt.Println(TryFromJSON([]byte(`{"failure":"oops"}`), reflect.TypeOf(0)))
+	fmt.Println(TupleFromJSON([]byte(`[1,"a",true]`), reflect.TypeOf(0), reflect.TypeOf(""), reflect.TypeOf(false)))
+	fmt.Println(SliceFromJSON([]byte(`[1,2,3]`), reflect.TypeOf([]int{})))
+	fmt.Println(MapFromJSON([]byte(`[{"key":1,"value":"a"}]`), reflect.TypeOf(map[int]string{})))
+
+	// Output:
+	// Some(1) <nil>
+	// None <nil>
+	// Left(oops) <nil>
+	// Right(1) <nil>
+	// Success([1 2]) <nil>
+	// Failure(oops) <nil>
+	// (1,a,true) <nil>
+	// [1 2 3] <nil>
+	// map[1:a] <nil>
+}
+
+func ExampleTuple2_UnmarshalJSON() {
+	var t Tuple2
+	if err := json.Unmarshal([]byte(`[1,"a"]`), &t); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(t, t.T1(), t.T2())
+
+	typed := Tuple2Of(0, "")
+	if err := json.Unmarshal([]byte(`[1,"a"]`), &typed); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(typed, typed.T1(), typed.T2())
+
+	var p Pair
+	if err := json.Unmarshal([]byte(`{"key":"a","value":null}`), &p); err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(p.Key(), p.Value())
+
+	// Output:
+	// (1,a) float64 string
+	// (1,a) int string
+	// a <nil>
+}
+
+func TestJSON_RoundTrip(t *testing.T) {
+	tests := []struct {
+		x      Any
+		decode func([]byte) (interface{}, error)
+	}{
+		{SomeOf(1), func(data []byte) (interface{}, error) { return OptionFromJSON(data, reflect.TypeOf(0)) }},
+		{None, func(data []byte) (interface{}, error) { return OptionFromJSON(data, reflect.TypeOf(0)) }},
+		{LeftOf("a"), func(data []byte) (interface{}, error) {
+			return EitherFromJSON(data, reflect.TypeOf(""), reflect.TypeOf(0))
+		}},
+		{LeftOf(nil), func(data []byte) (interface{}, error) { return EitherFromJSON(data, nil, nil) }},
+		{SuccessOf(nil), func(data []byte) (interface{}, error) { return TryFromJSON(data, nil) }},
+		{newTraitTry(true, Tuple2Of("a", true)), func(data []byte) (interface{}, error) {
+			return TryFromJSON(data, reflect.TypeOf(Tuple2{}))
+		}},
+		{Tuple4Of(1, "a", true, 2.5), func(data []byte) (interface{}, error) {
+			return TupleFromJSON(data, reflect.TypeOf(0), reflect.TypeOf(""), reflect.TypeOf(true), reflect.TypeOf(0.0))
+		}},
+		{SliceOf([]string{"a", "b"}), func(data []byte) (interface{}, error) {
+			return SliceFromJSON(data, reflect.TypeOf([]string{}))
+		}},
+		{MapOf(map[int][]string{1: {"a"}, 2: nil}), func(data []byte) (interface{}, error) {
+			return MapFromJSON(data, reflect.TypeOf(map[int][]string{}))
+		}},
+		{MapOf(map[string]int{}), func(data []byte) (interface{}, error) {
+			return MapFromJSON(data, reflect.TypeOf(map[string]int{}))
+		}},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(test.x)
+		if err != nil {
+			t.Fatalf("marshal %v: %v", test.x, err)
+		}
+
+		y, err := test.decode(data)
+		if err != nil {
+			t.Fatalf("unmarshal %s: %v", data, err)
+		}
+
+		if !Equal(test.x, y) {
+			t.Errorf("expect %v, got %v from %s", test.x, y, data)
+		}
+	}
+}
+
+func TestJSON_Invalid(t *testing.T) {
+	if _, err := EitherFromJSON([]byte(`{}`), nil, nil); err == nil {
+		t.Errorf("Either without left and right should fail")
+	}
+
+	if _, err := TryFromJSON([]byte(`{"success":1,"failure":"oops"}`), nil); err == nil {
+		t.Errorf("Try with both success and failure should fail")
+	}
+
+	typed := Tuple2Of(0, "")
+	if err := json.Unmarshal([]byte(`[1,"a",2]`), &typed); err == nil {
+		t.Errorf("Tuple2 with 3 elements should fail")
+	}
+
+	for _, data := range []string{`[1]`, `[1,2,3]`} {
+		var t2 Tuple2
+		if err := json.Unmarshal([]byte(data), &t2); err == nil {
+			t.Errorf("Tuple2 from %s should fail", data)
+		}
+	}
+
+	for _, data := range []string{`[1,2]`, `[1,2,3,4]`} {
+		var t3 Tuple3
+		if err := json.Unmarshal([]byte(data), &t3); err == nil {
+			t.Errorf("Tuple3 from %s should fail", data)
+		}
+	}
+
+	var t4 Tuple4
+	if err := json.Unmarshal([]byte(`[1,2,3,4,5]`), &t4); err == nil {
+		t.Errorf("Tuple4 from 5 elements should fail")
+	}
+	if err := json.Unmarshal([]byte(`[1,2,3]`), &t4); err == nil {
+		t.Errorf("Tuple4 from 3 elements should fail")
+	}
+
+	if _, err := MapFromJSON([]byte(`{"a":1}`), reflect.TypeOf(struct{ A int }{})); err == nil {
+		t.Errorf("Map from struct type should fail")
+	}
+
+	if _, err := SliceFromJSON([]byte(`[1]`), reflect.TypeOf(0)); err == nil {
+		t.Errorf("Slice from int type should fail")
+	}
+
+	if _, err := OptionFromJSON([]byte(`"a"`), reflect.TypeOf(0)); err == nil {
+		t.Errorf("string to int should fail")
+	}
+}
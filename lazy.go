@@ -0,0 +1,89 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+)
+
+// Lazy represents a lazy val in Scala.
+// The value is computed at most once on first access, and it is safe for concurrent use.
+// If computing panics, every access panics with the same PanicError.
+type Lazy interface {
+	Any
+
+	// Evaluated returns true if the value is computed.
+	Evaluated() bool
+
+	// Map returns a Lazy applying f to the value of this when it is accessed.
+	// f: func(T) X
+	Map(f interface{}) Lazy
+}
+
+type lazy struct {
+	once sync.Once
+	f    func() reflect.Value
+	v    reflect.Value
+	err  *PanicError
+	done uint32
+}
+
+var _ Lazy = &lazy{}
+
+func (l *lazy) rv() reflect.Value {
+	l.once.Do(func() {
+		defer func() {
+			if r := recover(); r != nil {
+				l.err = panicFailure(r).Get().(*PanicError)
+			}
+			l.f = nil
+			atomic.StoreUint32(&l.done, 1)
+		}()
+
+		l.v = l.f()
+	})
+
+	if l.err != nil {
+		panic(l.err)
+	}
+	return l.v
+}
+
+func (l *lazy) Get() interface{} {
+	return l.rv().Interface()
+}
+
+func (l *lazy) Evaluated() bool {
+	return atomic.LoadUint32(&l.done) == 1
+}
+
+func (l *lazy) String() string {
+	if l.Evaluated() {
+		if l.err != nil {
+			return fmt.Sprintf("Lazy(panic: %v)", l.err)
+		}
+		return fmt.Sprintf("Lazy(%v)", l.Get())
+	}
+	return "Lazy(?)"
+}
+
+func (l *lazy) Map(f interface{}) Lazy {
+	fw := funcOf(f)
+	return newLazy(func() reflect.Value {
+		return fw.call(l.rv())
+	})
+}
+
+func newLazy(f func() reflect.Value) *lazy {
+	return &lazy{f: f}
+}
+
+// LazyOf returns a Lazy with value computed by f.
+// f: func() T
+func LazyOf(f interface{}) Lazy {
+	fw := funcOf(f)
+	return newLazy(func() reflect.Value {
+		return fw.call(unitValue)
+	})
+}
@@ -0,0 +1,90 @@
+package monadgo
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func ExampleLazyOf() {
+	l := LazyOf(func() int {
+		fmt.Println("compute")
+		return 10
+	})
+
+	fmt.Println(l, l.Evaluated())
+	fmt.Println(l.Get())
+	fmt.Println(l.Get())
+	fmt.Println(l, l.Evaluated())
+
+	m := l.Map(func(x int) string {
+		return fmt.Sprintf("#%d", x)
+	})
+	printGet(m.Get())
+
+	// Output:
+	// Lazy(?) false
+	// compute
+	// 10
+	// 10
+	// Lazy(10) true
+	// #10, string
+}
+
+func TestLazy_Concurrent(t *testing.T) {
+	var count int32
+	l := LazyOf(func() int32 {
+		return atomic.AddInt32(&count, 1)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if x := l.Get(); x != int32(1) {
+				t.Errorf("expect 1, but %v", x)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if count != 1 {
+		t.Errorf("expect computing once, but %d", count)
+	}
+}
+
+func TestLazy_Panic(t *testing.T) {
+	count := 0
+	l := LazyOf(func() int {
+		count++
+		panic("boom")
+	})
+
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				err, ok := recover().(*PanicError)
+				if !ok || err.Value != "boom" {
+					t.Errorf("expect PanicError(boom), but %v", err)
+				}
+			}()
+			l.Get()
+		}()
+	}
+
+	if count != 1 || !l.Evaluated() {
+		t.Errorf("expect computed once, but %d", count)
+	}
+
+	if s := l.String(); s != "Lazy(panic: boom)" {
+		t.Errorf("unexpected %s", s)
+	}
+
+	if r := TryOf(func() int { return l.Map(func(x int) int { return x }).Get().(int) }); r.OK() {
+		t.Errorf("expect Failure, but %v", r)
+	} else if err, ok := r.Get().(*PanicError); !ok || err.Value != "boom" {
+		t.Errorf("expect Failure of PanicError(boom), but %v", r)
+	}
+}
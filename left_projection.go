@@ -6,6 +6,9 @@ import (
 )
 
 // LeftProjection represents scala-like LeftProjection[A,B].
+// Results from Map and FlatMap are right-biased Either,
+// and E returns the right-biased Either back.
+// Either.LeftMap maps Left without projecting.
 type LeftProjection interface {
 	Any
 
@@ -38,10 +38,11 @@ func mapCBF(k, v reflect.Type, x interface{}) Traversable {
 
 	if t.rv().Type().Elem().ConvertibleTo(typePair) {
 		var ret reflect.Value
+		idx := make(keyIndex)
 		v := t.rv()
 		len := t.Size()
 		for i := 0; i < len; i++ {
-			ret = mergeMap(ret, v.Index(i))
+			ret = mergeMap(ret, v.Index(i), idx)
 		}
 		return newMap(ret)
 	}
@@ -63,8 +64,13 @@ func mapFromValue(v reflect.Value) Map {
 		var ret reflect.Value
 
 		len := v.Len()
+		if len <= 0 {
+			return newMap(makeMap(typeInterface, typeInterface, 0))
+		}
+
+		idx := make(keyIndex)
 		for i := 0; i < len; i++ {
-			ret = mergeMap(ret, v.Index(i))
+			ret = mergeMap(ret, v.Index(i), idx)
 		}
 
 		return newMap(ret)
@@ -83,6 +89,7 @@ func mapFromValue(v reflect.Value) Map {
 }
 
 // MapOf returns a Map.
+// It panics if x is nil or can not convert to a Map, use TryMapOf instead.
 func MapOf(x interface{}) Map {
 	if x == nil {
 		panic("x is nil")
@@ -96,6 +103,14 @@ func MapOf(x interface{}) Map {
 	}
 }
 
+// TryMapOf returns Success of Map,
+// or Failure if x is nil or can not convert to a Map.
+func TryMapOf(x interface{}) Try {
+	return TryOf(func() Map {
+		return MapOf(x)
+	})
+}
+
 func newMap(v reflect.Value) Map {
 	t := v.Type()
 	return _map{
@@ -182,16 +197,38 @@ func (m _map) Forall(f interface{}) bool {
 }
 
 // Reduce reduces the elements of this using the specified associative binary operator.
+// It panics if this is empty, use ReduceOption instead.
 // f: func(T, T) T. T can be monadgo Pair or Go tuple (K,V).
 // returns Pair.
 func (m _map) Reduce(f interface{}) interface{} {
 	return m.toSeq().Reduce(f)
 }
 
+// ReduceOption reduces the elements of this using the specified associative binary operator,
+// or returns None if this is empty.
+// f: func(T, T) T. T can be monadgo Pair or Go tuple (K,V).
+// returns Option of Pair.
+func (m _map) ReduceOption(f interface{}) Option {
+	return m.toSeq().ReduceOption(f)
+}
+
+// ReduceLeftOption reduces the elements of this going left to right using the specified binary operator,
+// or returns None if this is empty.
+// f: func(T, T) T. T can be monadgo Pair or Go tuple (K,V).
+// returns Option of Pair.
+func (m _map) ReduceLeftOption(f interface{}) Option {
+	return m.toSeq().ReduceLeftOption(f)
+}
+
 // GroupBy returns Map with K -> Go map. Key is the result of f. Collect elements into a map with same resulting key value.
+// Returns an empty Map if this is empty.
 // f: func(T) X. T can be monadgo Pair or Go tuple (K,V).
 // returns Map(X -> Go map[K]V)
 func (m _map) GroupBy(f interface{}) Map {
+	if m.Size() <= 0 {
+		return newMap(makeMap(funcOf(f).out[0], m.v.Type(), 0))
+	}
+
 	x := m.toSeq().GroupBy(f)
 	x2 := x.Map(func(p Pair) Pair {
 		return PairOf(p.Key(), MapOf(p.Value()).Get())
@@ -200,6 +237,37 @@ func (m _map) GroupBy(f interface{}) Map {
 	return x2.(Map)
 }
 
+// GroupMap returns Map with X -> Go slice. Key is the result of key, and value is the result of value.
+// key: func(T) X. T can be monadgo Pair or Go tuple (K,V).
+// value: func(T) Y. T can be monadgo Pair or Go tuple (K,V).
+// returns Map(X -> Go slice of Y)
+func (m _map) GroupMap(key, value interface{}) Map {
+	return m.toSeq().GroupMap(key, value)
+}
+
+// GroupMapReduce returns Map with X -> Y. Key is the result of key, and value is the result of value.
+// key: func(T) X. T can be monadgo Pair or Go tuple (K,V).
+// value: func(T) Y. T can be monadgo Pair or Go tuple (K,V).
+// reduce: func(Y, Y) Y
+// returns Map(X -> Y)
+func (m _map) GroupMapReduce(key, value, reduce interface{}) Map {
+	return m.toSeq().GroupMapReduce(key, value, reduce)
+}
+
+// CountBy returns Map with X -> int. Key is the result of f.
+// f: func(T) X. T can be monadgo Pair or Go tuple (K,V).
+// returns Map(X -> int)
+func (m _map) CountBy(f interface{}) Map {
+	return m.toSeq().CountBy(f)
+}
+
+// PartitionMap applies f to all elements and splits results into a pair of Lefts and Rights.
+// f: func(T) Either or func(T) (X, error). T can be monadgo Pair or Go tuple (K,V).
+// returns Tuple2(Go slice of Left values, Go slice of Right values)
+func (m _map) PartitionMap(f interface{}) Tuple2 {
+	return m.toSeq().PartitionMap(f)
+}
+
 // Exists tests whether a predicate holds for at least one element of this sequence.
 // f: func(T) bool. T can be monadgo Pair or Go tuple (K,V).
 func (m _map) Exists(f interface{}) bool {
@@ -234,9 +302,51 @@ func (m _map) Split(f interface{}) Tuple2 {
 	)
 }
 
+// MapE applies function f to all elements in map m.
+// f: func(T) (X, error) or func(T) (X, bool). T can be monadgo Pair or Go tuple (K,V).
+// returns Success of a Map if X is Pair,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+func (m _map) MapE(f interface{}, mode ...ErrorMode) Try {
+	return m.toSeq().MapE(f, mode...).Map(m.mapCBF)
+}
+
+// FlatMapE applies f to all elements and builds a new Travesable from result.
+// f: func(T) (X, error) or func(T) (X, bool). T can be monadgo Pair or Go tuple (K,V).
+// returns Success of a Map if X is a Go slice with element type Pair or a Go map,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+func (m _map) FlatMapE(f interface{}, mode ...ErrorMode) Try {
+	return m.toSeq().FlatMapE(f, mode...).Map(m.mapCBF)
+}
+
+// FoldE folds the elements using specified binary operator.
+// f: func(Z, T) (Z, error) or func(Z, T) (Z, bool). T can be monadgo Pair or Go tuple (K,V).
+// returns Success of value with type Z,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+func (m _map) FoldE(z, f interface{}, mode ...ErrorMode) Try {
+	return m.toSeq().FoldE(z, f, mode...)
+}
+
+// ForeachE applies f to all element.
+// f: func(T) error or func(T) bool. T can be monadgo Pair or Go tuple (K,V).
+// returns Success of Unit,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+func (m _map) ForeachE(f interface{}, mode ...ErrorMode) Try {
+	return m.toSeq().ForeachE(f, mode...)
+}
+
 // Collect returns elements satisfying pf.
 // pf is a partial function consisting of Condition func(T) bool and Action func(T) X. T can be monadgo Pair or Go tuple (K,V).
 // returns a Map if type of X is Pair.
 func (m _map) Collect(pf PartialFunc) Traversable {
 	return m.mapCBF(m.toSeq().Collect(pf))
 }
+
+// Equal reports whether this and other have deeply equal bindings, like Equal.
+func (m _map) Equal(other Any) bool {
+	return Equal(m, other)
+}
+
+// Hash returns the hash of bindings, consistent with Equal.
+func (m _map) Hash() uint64 {
+	return Hash(m)
+}
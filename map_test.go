@@ -2,6 +2,7 @@ package monadgo
 
 import (
 	"fmt"
+	"strconv"
 )
 
 func ExampleMapOf() {
@@ -448,3 +449,78 @@ func ExampleMap_Collect() {
 	// 3 33
 	// 4 44
 }
+
+func ExampleMap_GroupMapReduce() {
+	m := MapOf(map[string]int{
+		"a":  1,
+		"b":  2,
+		"cc": 3,
+	}).GroupMapReduce(func(k string, _ int) int {
+		return len(k)
+	}, func(_ string, v int) int {
+		return v
+	}, func(x, y int) int {
+		return x + y
+	})
+	printGet(m.Get())
+
+	// Output:
+	// map[1:3 2:3], map[int]int
+}
+
+func ExampleMap_CountBy() {
+	m := MapOf(map[string]int{
+		"a":  1,
+		"b":  2,
+		"cc": 3,
+	}).CountBy(func(k string, _ int) int {
+		return len(k)
+	})
+	printGet(m.Get())
+
+	// Output:
+	// map[1:2 2:1], map[int]int
+}
+
+func ExampleTryMapOf() {
+	fmt.Println(TryMapOf(map[string]int{"a": 1}))
+	fmt.Println(TryMapOf(nil))
+	fmt.Println(TryMapOf([]Pair{}))
+
+	// Output:
+	// Success(map[a:1])
+	// Failure(x is nil)
+	// Success(map[])
+}
+
+func ExampleMap_ReduceOption() {
+	m := MapOf(map[int]int{1: 11, 2: 22})
+	fmt.Println(m.ReduceOption(func(k1, v1, k2, v2 int) Pair {
+		return PairOf(k1+k2, v1+v2)
+	}))
+
+	m = MapOf(map[int]int{})
+	fmt.Println(m.ReduceOption(func(k1, v1, k2, v2 int) Pair {
+		return PairOf(k1+k2, v1+v2)
+	}))
+
+	printGet(m.GroupBy(func(k, v int) bool {
+		return k > v
+	}).Get())
+
+	// Output:
+	// Some((3,33))
+	// None
+	// map[], map[bool]map[int]int
+}
+
+func ExampleMap_MapE() {
+	t := MapOf(map[string]string{"a": "1", "b": "2"}).MapE(func(k, v string) (Pair, error) {
+		n, err := strconv.Atoi(v)
+		return PairOf(k, n), err
+	})
+	printGet(t.Get().(Map).Get())
+
+	// Output:
+	// map[a:1 b:2], map[string]int
+}
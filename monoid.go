@@ -0,0 +1,56 @@
+package monadgo
+
+import (
+	"reflect"
+)
+
+// Monoid represents cats-like Monoid[T], an identity element with an associative binary operator.
+type Monoid interface {
+	// Empty returns the identity element.
+	Empty() interface{}
+
+	// Combine combines x and y.
+	Combine(x, y interface{}) interface{}
+}
+
+type monoid struct {
+	empty   interface{}
+	combine funcTR
+}
+
+func (m *monoid) Empty() interface{} {
+	return m.empty
+}
+
+func (m *monoid) Combine(x, y interface{}) interface{} {
+	return m.combine.fold(x, y)
+}
+
+// MonoidOf returns a Monoid.
+// empty: the identity element with type T.
+// combine: func(T, T) T
+func MonoidOf(empty, combine interface{}) Monoid {
+	return &monoid{
+		empty:   empty,
+		combine: foldOf(combine),
+	}
+}
+
+// StringMonoid is a Monoid concatenating strings.
+var StringMonoid = MonoidOf("", func(x, y string) string {
+	return x + y
+})
+
+// SliceMonoidOf returns a Monoid concatenating Go slices with the same type of empty.
+// Combine always returns a new Go slice.
+func SliceMonoidOf(empty interface{}) Monoid {
+	t := reflect.TypeOf(empty)
+	fval := reflect.MakeFunc(reflect.FuncOf([]reflect.Type{t, t}, []reflect.Type{t}, false), func(args []reflect.Value) []reflect.Value {
+		x, y := args[0], args[1]
+		ret := reflect.MakeSlice(t, 0, x.Len()+y.Len())
+		ret = reflect.AppendSlice(ret, x)
+		return []reflect.Value{reflect.AppendSlice(ret, y)}
+	})
+
+	return MonoidOf(reflect.MakeSlice(t, 0, 0).Interface(), fval.Interface())
+}
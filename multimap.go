@@ -0,0 +1,184 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MultiMap represents a scala-like MultiMap, K -> Go slice of V.
+// It has the same shape as the result of GroupBy.
+// Keys like Option and Tuple are compared with Equal.
+type MultiMap interface {
+	Map
+
+	// Add appends v to the values bound to k, and returns this.
+	Add(k, v interface{}) MultiMap
+
+	// Remove removes all values equal to v bound to k, and returns this.
+	// k is removed if no value is bound to it.
+	Remove(k, v interface{}) MultiMap
+
+	// Values returns a Slice of values bound to k.
+	// The returned Slice is empty if k does not exist.
+	Values(k interface{}) Slice
+
+	// Flatten returns a Slice of Pair(K, V) for all bindings.
+	Flatten() Slice
+
+	// KeyCount returns number of keys.
+	KeyCount() int
+
+	// ValueCount returns number of values bound to all keys.
+	ValueCount() int
+}
+
+type multiMap struct {
+	_map
+}
+
+var _ MultiMap = multiMap{}
+
+func newMultiMap(v reflect.Value) MultiMap {
+	return multiMap{newMap(v).(_map)}
+}
+
+func multiMapFromValue(v reflect.Value) MultiMap {
+	if !v.IsValid() {
+		panic("v is invalid")
+	}
+
+	if v.Type().Implements(typeSeq) {
+		if m, ok := v.Interface().(multiMap); ok {
+			return m
+		}
+		return multiMapFromValue(v.Interface().(sequence).rv())
+	}
+
+	if v.Kind() == reflect.Map && v.Type().Elem().Kind() == reflect.Slice {
+		return newMultiMap(v)
+	}
+
+	if v.Kind() == reflect.Slice && v.Type().Elem().ConvertibleTo(typePair) {
+		len := v.Len()
+		if len <= 0 {
+			return newMultiMap(makeMap(typeInterface, reflect.SliceOf(typeInterface), 0))
+		}
+
+		p := v.Index(0).Interface().(Pair)
+		ret := newMultiMap(makeMap(p.T1(), reflect.SliceOf(p.T2()), -1))
+		for i := 0; i < len; i++ {
+			p = v.Index(i).Interface().(Pair)
+			ret.Add(p.Key(), p.Value())
+		}
+		return ret
+	}
+
+	panic(fmt.Sprintf("%v can not convert to multimap", v.Interface()))
+}
+
+// MultiMapOf returns a MultiMap.
+// x can be a Go map with slice values, a Map from GroupBy,
+// or a Go slice or Slice of Pair.
+func MultiMapOf(x interface{}) MultiMap {
+	if x == nil {
+		panic("x is nil")
+	}
+
+	switch v := x.(type) {
+	case reflect.Value:
+		return multiMapFromValue(v)
+	default:
+		return multiMapFromValue(reflect.ValueOf(x))
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// valueOrZero returns reflect.Value of x, or zero value of t if x is nil.
+func valueOrZero(t reflect.Type, x interface{}) reflect.Value {
+	if x == nil {
+		return reflect.Zero(t)
+	}
+	return reflect.ValueOf(x)
+}
+
+// Add appends v to the values bound to k, and returns this.
+func (m multiMap) Add(k, v interface{}) MultiMap {
+	kval := mapKey(m.v, valueOrZero(m.ktype, k))
+
+	vs := m.v.MapIndex(kval)
+	if !vs.IsValid() {
+		vs = makeSlice(m.vtype.Elem())
+	}
+
+	m.v.SetMapIndex(kval, reflect.Append(vs, valueOrZero(m.vtype.Elem(), v)))
+	return m
+}
+
+// Remove removes all values equal to v bound to k, and returns this.
+// k is removed if no value is bound to it.
+func (m multiMap) Remove(k, v interface{}) MultiMap {
+	kval := mapKey(m.v, valueOrZero(m.ktype, k))
+
+	vs := m.v.MapIndex(kval)
+	if !vs.IsValid() {
+		return m
+	}
+
+	ret := makeSlice(m.vtype.Elem(), 0, vs.Len())
+	for i := 0; i < vs.Len(); i++ {
+		if !reflect.DeepEqual(vs.Index(i).Interface(), v) {
+			ret = reflect.Append(ret, vs.Index(i))
+		}
+	}
+
+	if ret.Len() <= 0 {
+		m.v.SetMapIndex(kval, reflect.Value{})
+	} else {
+		m.v.SetMapIndex(kval, ret)
+	}
+
+	return m
+}
+
+// Values returns a Slice of values bound to k.
+// The returned Slice is empty if k does not exist.
+func (m multiMap) Values(k interface{}) Slice {
+	vs := m.v.MapIndex(mapKey(m.v, valueOrZero(m.ktype, k)))
+	if !vs.IsValid() {
+		return SliceOf(makeSlice(m.vtype.Elem()))
+	}
+	return SliceOf(vs)
+}
+
+// Flatten returns a Slice of Pair(K, V) for all bindings.
+func (m multiMap) Flatten() Slice {
+	ret := makeSlice(typePair, 0, m.ValueCount())
+
+	it := m.v.MapRange()
+	for it.Next() {
+		vs := it.Value()
+		for i := 0; i < vs.Len(); i++ {
+			p := Pair{newTuple2(m.ktype, m.vtype.Elem(), it.Key(), vs.Index(i))}
+			ret = reflect.Append(ret, reflect.ValueOf(p))
+		}
+	}
+
+	return SliceOf(ret)
+}
+
+// KeyCount returns number of keys.
+func (m multiMap) KeyCount() int {
+	return m.v.Len()
+}
+
+// ValueCount returns number of values bound to all keys.
+func (m multiMap) ValueCount() int {
+	count := 0
+
+	it := m.v.MapRange()
+	for it.Next() {
+		count += it.Value().Len()
+	}
+	return count
+}
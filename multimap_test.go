@@ -0,0 +1,69 @@
+package monadgo
+
+import (
+	"fmt"
+)
+
+func ExampleMultiMapOf() {
+	m := MultiMapOf(SliceOf([]int{1, 2, 3, 4, 5}).GroupBy(func(x int) int {
+		return x % 2
+	}))
+	printGet(m.Get())
+
+	m = MultiMapOf([]Pair{PairOf("a", 1), PairOf("b", 2), PairOf("a", 3)})
+	printGet(m.Get())
+
+	m = MultiMapOf(map[string][]int{"a": {1, 2}})
+	printGet(m.Get())
+
+	m = MultiMapOf([]Pair{})
+	printGet(m.Get())
+
+	// Output:
+	// map[0:[2 4] 1:[1 3 5]], map[int][]int
+	// map[a:[1 3] b:[2]], map[string][]int
+	// map[a:[1 2]], map[string][]int
+	// map[], map[interface {}][]interface {}
+}
+
+func ExampleMultiMap_Add() {
+	m := MultiMapOf(map[string][]int{})
+	m.Add("a", 1).Add("b", 2).Add("a", 3)
+	printGet(m.Get())
+	fmt.Println(m.KeyCount(), m.ValueCount())
+
+	// Output:
+	// map[a:[1 3] b:[2]], map[string][]int
+	// 2 3
+}
+
+func ExampleMultiMap_Remove() {
+	m := MultiMapOf(map[string][]int{"a": {1, 2, 1}, "b": {2}})
+	m.Remove("a", 1).Remove("b", 2).Remove("c", 3)
+	printGet(m.Get())
+
+	// Output:
+	// map[a:[2]], map[string][]int
+}
+
+func ExampleMultiMap_Values() {
+	m := MultiMapOf(map[string][]int{"a": {1, 2}})
+	printGet(m.Values("a").Get())
+	printGet(m.Values("b").Get())
+
+	// Output:
+	// [1 2], []int
+	// [], []int
+}
+
+func ExampleMultiMap_Flatten() {
+	m := MultiMapOf(map[string][]int{"a": {1, 2}, "b": {3}})
+	m.Flatten().Foreach(func(k string, v int) {
+		fmt.Println(k, v)
+	})
+
+	// Unordered output:
+	// a 1
+	// a 2
+	// b 3
+}
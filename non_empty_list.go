@@ -0,0 +1,131 @@
+package monadgo
+
+import (
+	"fmt"
+)
+
+// NonEmptyList represents cats-like NonEmptyList, a Slice with at least one element.
+// Head and Reduce never panic on NonEmptyList, and Max and Min never panic on ordered elements.
+type NonEmptyList interface {
+	Slice
+
+	// Last returns the last element.
+	Last() interface{}
+
+	// Max returns the largest element.
+	// Elements must be ordered with each other like Compare, eg: int, uint, float, string, Option and Tuple.
+	// It panics if elements are not ordered, like structs, or int mixed with string.
+	Max() interface{}
+
+	// Min returns the smallest element.
+	// Elements must be ordered with each other like Compare, eg: int, uint, float, string, Option and Tuple.
+	// It panics if elements are not ordered, like structs, or int mixed with string.
+	Min() interface{}
+
+	// MaxBy returns the first element with the largest result of f.
+	// It panics if results of f are not ordered.
+	// f: func(T) K, K must be ordered like Compare.
+	MaxBy(f interface{}) interface{}
+
+	// MinBy returns the first element with the smallest result of f.
+	// It panics if results of f are not ordered.
+	// f: func(T) K, K must be ordered like Compare.
+	MinBy(f interface{}) interface{}
+}
+
+type nonEmptyList struct {
+	seq
+}
+
+var _ NonEmptyList = nonEmptyList{}
+
+// NonEmptyListOf returns a NonEmptyList with head and elements in tail.
+// tail can be nil, Go slice or Slice.
+func NonEmptyListOf(head, tail interface{}) NonEmptyList {
+	ret := oneToSlice(valueOf(head))
+
+	t := seqOf(tail)
+	for i := 0; i < t.len; i++ {
+		ret = widenAppendSlice(ret, t.v.Index(i))
+	}
+
+	return nonEmptyList{seqFromValue(ret)}
+}
+
+// NonEmptyOf returns Some of NonEmptyList if x is not empty, or None.
+// x can be Go slice or Slice.
+func NonEmptyOf(x interface{}) Option {
+	s := seqOf(x)
+	if s.len <= 0 {
+		return None
+	}
+
+	return SomeOf(nonEmptyList{s})
+}
+
+// ----------------------------------------------------------------------------
+
+func (l nonEmptyList) String() string {
+	return fmt.Sprintf("NonEmptyList(%v)", l.x)
+}
+
+// Map applies function f to all elements.
+// f: func(T) X
+// returns a NonEmptyList with element type X.
+func (l nonEmptyList) Map(f interface{}) Traversable {
+	return nonEmptyList{l.seq.Map(f).toSeq()}
+}
+
+// Reverse returns new NonEmptyList with elements in reversed order.
+func (l nonEmptyList) Reverse() Traversable {
+	return nonEmptyList{l.seq.Reverse().toSeq()}
+}
+
+// Last returns the last element.
+func (l nonEmptyList) Last() interface{} {
+	return l.v.Index(l.len - 1).Interface()
+}
+
+// Max returns the largest element.
+func (l nonEmptyList) Max() interface{} {
+	return l.MaxBy(func(x interface{}) interface{} {
+		return x
+	})
+}
+
+// Min returns the smallest element.
+func (l nonEmptyList) Min() interface{} {
+	return l.MinBy(func(x interface{}) interface{} {
+		return x
+	})
+}
+
+// MaxBy returns the first element with the largest result of f.
+// f: func(T) K, K must be ordered like Compare.
+func (l nonEmptyList) MaxBy(f interface{}) interface{} {
+	return l.extremeBy(f, 1)
+}
+
+// MinBy returns the first element with the smallest result of f.
+// f: func(T) K, K must be ordered like Compare.
+func (l nonEmptyList) MinBy(f interface{}) interface{} {
+	return l.extremeBy(f, -1)
+}
+
+// extremeBy returns the first element x which compareDeep(f(x), f(y)) is sign for other y.
+func (l nonEmptyList) extremeBy(f interface{}, sign int) interface{} {
+	fw := funcOf(f)
+
+	ret := l.v.Index(0)
+	key := fw.call(ret)
+
+	for i := 1; i < l.len; i++ {
+		x := l.v.Index(i)
+		k := fw.call(x)
+		if compareDeep(k, key) == sign {
+			ret, key = x, k
+		}
+	}
+
+	return ret.Interface()
+}
@@ -0,0 +1,100 @@
+package monadgo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleNonEmptyListOf() {
+	l := NonEmptyListOf(1, []int{2, 3})
+	fmt.Println(l)
+	printGet(l.Get())
+	printGet(l.Head())
+	printGet(l.Last())
+
+	l = NonEmptyListOf("a", nil)
+	printGet(l.Get())
+
+	// Output:
+	// NonEmptyList([1 2 3])
+	// [1 2 3], []int
+	// 1, int
+	// 3, int
+	// [a], []string
+}
+
+func ExampleNonEmptyOf() {
+	fmt.Println(NonEmptyOf([]int{1, 2}))
+	fmt.Println(NonEmptyOf([]int{}))
+
+	NonEmptyOf(SliceOf([]int{3, 1, 2})).Foreach(func(l NonEmptyList) {
+		fmt.Println(l.Reduce(func(x, y int) int {
+			return x + y
+		}))
+		fmt.Println(l.Max(), l.Min())
+	})
+
+	// Output:
+	// Some(NonEmptyList([1 2]))
+	// None
+	// 6
+	// 3 1
+}
+
+func ExampleNonEmptyList_MaxBy() {
+	l := NonEmptyListOf("bb", []string{"a", "ccc", "ddd"})
+	fmt.Println(l.MaxBy(func(x string) int {
+		return len(x)
+	}))
+	fmt.Println(l.MinBy(func(x string) int {
+		return len(x)
+	}))
+
+	// Output:
+	// ccc
+	// a
+}
+
+func ExampleNonEmptyList_Map() {
+	l := NonEmptyListOf(1, []int{2, 3}).Map(func(x int) string {
+		return fmt.Sprintf("%d", x*10)
+	})
+	fmt.Println(l)
+	printGet(l.Get())
+
+	// Output:
+	// NonEmptyList([10 20 30])
+	// [10 20 30], []string
+}
+
+func TestNonEmptyList_MaxNotOrdered(t *testing.T) {
+	type point struct {
+		x, y int
+	}
+
+	lists := []NonEmptyList{
+		NonEmptyListOf(point{1, 2}, []point{{3, 4}}),
+		NonEmptyListOf(1, []interface{}{"a"}),
+	}
+
+	for _, l := range lists {
+		for _, f := range []func() interface{}{l.Max, l.Min} {
+			func() {
+				defer func() {
+					if r := recover(); r == nil {
+						t.Errorf("Max and Min of %v should panic", l)
+					}
+				}()
+				f()
+			}()
+		}
+	}
+
+	options := NonEmptyListOf(SomeOf(2), []Option{None, SomeOf(3)})
+	if x := options.Max(); !Equal(x, SomeOf(3)) {
+		t.Errorf("expect Some(3), but %v", x)
+	}
+	if x := options.Min(); x != None {
+		t.Errorf("expect None, but %v", x)
+	}
+}
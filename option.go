@@ -15,6 +15,9 @@ type Option interface {
 	// Defined return true if this is Some, otherwise return false.
 	Defined() bool
 
+	// Empty returns true if this is None, otherwise return false.
+	Empty() bool
+
 	// Map applies function f if this is Some.
 	// f: func(T) X
 	// returns Option[X]
@@ -25,6 +28,45 @@ type Option interface {
 	// returns a new Option.
 	FlatMap(f interface{}) Option
 
+	// Flatten returns the nested Option if this is Some of Option,
+	// otherwise return this.
+	Flatten() Option
+
+	// Filter returns this if this is Some and f returns true,
+	// otherwise return None.
+	// f: func(T) bool
+	Filter(f interface{}) Option
+
+	// FilterNot returns this if this is Some and f returns false,
+	// otherwise return None.
+	// f: func(T) bool
+	FilterNot(f interface{}) Option
+
+	// Exists returns true if this is Some and f returns true.
+	// f: func(T) bool
+	Exists(f interface{}) bool
+
+	// Contains returns true if this is Some and the value equals x.
+	Contains(x interface{}) bool
+
+	// Collect returns Some of result from applying pf to the value if this is Some and pf is defined at the value,
+	// otherwise return None.
+	// pf is a partial function consisting of Condition func(T) bool and Action func(T) X.
+	Collect(pf PartialFunc) Option
+
+	// Zip returns Some of Tuple2 with values of this and that if both are Some,
+	// otherwise return None.
+	Zip(that Option) Option
+
+	// ZipWith returns Some of result from applying f to values of this and that if both are Some,
+	// otherwise return None.
+	// f: func(T, U) X
+	ZipWith(that Option, f interface{}) Option
+
+	// Unzip returns Tuple2 of Some with values of Tuple2 or Pair if this is Some of them,
+	// otherwise return Tuple2 of None.
+	Unzip() Tuple2
+
 	// Foreach executes the given side-effecting function f if this is a Some.
 	// f: func(T)
 	Foreach(f interface{})
@@ -50,6 +92,44 @@ type Option interface {
 	// z: func() X or value with type X
 	// returns value with type X
 	GetOrElse(z interface{}) interface{}
+
+	// OrNull returns the value if this is Some, otherwise return nil.
+	OrNull() interface{}
+
+	// ToSlice returns Slice with the value if this is Some, otherwise return empty Slice.
+	ToSlice() Slice
+
+	// ToLeft returns Left of the value if this is Some, otherwise return Right of z.
+	// z: func() X or value with type X
+	ToLeft(z interface{}) Either
+
+	// ToRight returns Right of the value if this is Some, otherwise return Left of z.
+	// z: func() X or value with type X
+	ToRight(z interface{}) Either
+
+	// ToTry returns Success of the value if this is Some, otherwise return Failure of err.
+	// Failure of a no such element error is returned if err is nil.
+	ToTry(err error) Try
+
+	// ToPtr returns a pointer to a copy of the value if this is Some, otherwise return nil.
+	ToPtr() interface{}
+
+	// ToOk returns the value and true if this is Some, otherwise return nil and false.
+	ToOk() (interface{}, bool)
+
+	// ToValueErr returns the value and nil if this is Some,
+	// otherwise return nil and a no such element error.
+	ToValueErr() (interface{}, error)
+
+	// Equal reports whether this and other are both None, or both Some with deeply equal values.
+	Equal(other Any) bool
+
+	// Hash returns the hash of this, consistent with Equal.
+	Hash() uint64
+
+	// Compare compares this and other. None is less than Some, and values of Some are compared.
+	// returns -1 if this < other, 0 if this == other, and +1 if this > other.
+	Compare(other Option) int
 }
 
 // ----------------------------------------------------------------------------
@@ -132,10 +212,165 @@ func (o *traitOption) Foreach(f interface{}) {
 	}
 }
 
+func (o *traitOption) Flatten() Option {
+	if o.empty {
+		return None
+	}
+
+	return optionCBF(o.v)
+}
+
+func (o *traitOption) Filter(f interface{}) Option {
+	if o.empty || !funcOf(f).call(o.v).Bool() {
+		return None
+	}
+
+	return o
+}
+
+func (o *traitOption) FilterNot(f interface{}) Option {
+	if o.empty || funcOf(f).call(o.v).Bool() {
+		return None
+	}
+
+	return o
+}
+
+func (o *traitOption) Exists(f interface{}) bool {
+	if o.empty {
+		return false
+	}
+
+	return funcOf(f).call(o.v).Bool()
+}
+
+func (o *traitOption) Contains(x interface{}) bool {
+	if o.empty {
+		return false
+	}
+
+	return reflect.DeepEqual(o.Get(), x)
+}
+
+func (o *traitOption) Collect(pf PartialFunc) Option {
+	if o.empty {
+		return None
+	}
+
+	return optionCBF(pf.Call(o.v))
+}
+
+func (o *traitOption) Zip(that Option) Option {
+	return mapNOption(nil, o, that)
+}
+
+func (o *traitOption) ZipWith(that Option, f interface{}) Option {
+	return mapNOption(f, o, that)
+}
+
+func (o *traitOption) Unzip() Tuple2 {
+	if o.empty {
+		return Tuple2Of(None, None)
+	}
+
+	var t Tuple2
+	switch v := o.Get().(type) {
+	case Pair:
+		t = v.Tuple2
+	case Tuple2:
+		t = v
+	default:
+		return Tuple2Of(None, None)
+	}
+
+	return Tuple2Of(optionCBF(t.V1()), optionCBF(t.V2()))
+}
+
 func (o *traitOption) Empty() bool {
 	return o.empty
 }
 
+func (o *traitOption) OrNull() interface{} {
+	if o.empty {
+		return nil
+	}
+
+	return o.Get()
+}
+
+func (o *traitOption) ToSlice() Slice {
+	if o.empty {
+		return emptySeq
+	}
+
+	return SliceOf(oneToSlice(o.v))
+}
+
+func (o *traitOption) ToLeft(z interface{}) Either {
+	if o.empty {
+		return RightOf(checkAndInvoke(z))
+	}
+
+	return LeftOf(o.v)
+}
+
+func (o *traitOption) ToRight(z interface{}) Either {
+	if o.empty {
+		return LeftOf(checkAndInvoke(z))
+	}
+
+	return RightOf(o.v)
+}
+
+func (o *traitOption) ToTry(err error) Try {
+	if o.empty {
+		if err == nil {
+			err = ErrNoSuchElement
+		}
+		return newTraitTry(false, err)
+	}
+
+	return &traitTry{ok: true, v: o.v}
+}
+
+func (o *traitOption) ToPtr() interface{} {
+	if o.empty || o.v == nullValue {
+		return nil
+	}
+
+	ptr := reflect.New(o.v.Type())
+	ptr.Elem().Set(o.v)
+	return ptr.Interface()
+}
+
+func (o *traitOption) ToOk() (interface{}, bool) {
+	if o.empty {
+		return nil, false
+	}
+
+	return o.Get(), true
+}
+
+func (o *traitOption) ToValueErr() (interface{}, error) {
+	if o.empty {
+		return nil, ErrNoSuchElement
+	}
+
+	return o.Get(), nil
+}
+
+func (o *traitOption) Equal(other Any) bool {
+	return Equal(o, other)
+}
+
+func (o *traitOption) Hash() uint64 {
+	return Hash(o)
+}
+
+func (o *traitOption) Compare(other Option) int {
+	return Compare(o, other)
+}
+
 // ------------------------------------------------
 
 func optionCBF(x ...interface{}) Option {
@@ -191,3 +426,51 @@ func SomeOf(x ...interface{}) Option {
 func OptionOf(x ...interface{}) Option {
 	return optionCBF(x...)
 }
+
+// OptionFromPtr returns Some of the value pointed by x, or None if x is nil.
+// x: *T
+func OptionFromPtr(x interface{}) Option {
+	v := reflect.ValueOf(x)
+	if !v.IsValid() || v.IsNil() {
+		return None
+	}
+
+	return optionCBF(v.Elem())
+}
+
+// OptionFromOk returns Some of x if ok is true, otherwise return None.
+func OptionFromOk(x interface{}, ok bool) Option {
+	if !ok {
+		return None
+	}
+
+	return optionCBF(x)
+}
+
+// OptionFromZero returns None if x is nil or the zero value of its type, otherwise return Some of x.
+func OptionFromZero(x interface{}) Option {
+	if x == nil || reflect.ValueOf(x).IsZero() {
+		return None
+	}
+
+	return optionCBF(x)
+}
+
+// OptionFromMapLookup returns Some of the value with key k in Go map m, or None if k does not exist.
+func OptionFromMapLookup(m, k interface{}) Option {
+	v := reflect.ValueOf(m).MapIndex(reflect.ValueOf(k))
+	if !v.IsValid() {
+		return None
+	}
+
+	return optionCBF(v)
+}
+
+// OptionFromErr returns Some of x if err is nil, otherwise return None.
+func OptionFromErr(x interface{}, err error) Option {
+	if err != nil {
+		return None
+	}
+
+	return optionCBF(x)
+}
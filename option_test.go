@@ -1,7 +1,9 @@
 package monadgo
 
 import (
+	"errors"
 	"fmt"
+	"testing"
 )
 
 type test struct {
@@ -232,3 +234,106 @@ func ExampleOption_OrElse() {
 	// Some(1000)
 	// 1000, int
 }
+
+func ExampleOption_Filter() {
+	even := func(x int) bool {
+		return x%2 == 0
+	}
+
+	fmt.Println(SomeOf(2).Filter(even), SomeOf(3).Filter(even), None.Filter(even))
+	fmt.Println(SomeOf(2).FilterNot(even), SomeOf(3).FilterNot(even))
+	fmt.Println(SomeOf(2).Exists(even), SomeOf(3).Exists(even), None.Exists(even))
+	fmt.Println(SomeOf(2).Contains(2), SomeOf([]int{1}).Contains([]int{1}), None.Contains(2))
+
+	// Output:
+	// Some(2) None None
+	// None Some(3)
+	// true false false
+	// true true false
+}
+
+func ExampleOption_Collect() {
+	pf := PartialFuncOf(func(x int) bool {
+		return x > 0
+	}, func(x int) string {
+		return fmt.Sprintf("+%d", x)
+	})
+
+	fmt.Println(SomeOf(1).Collect(pf), SomeOf(-1).Collect(pf), None.Collect(pf))
+
+	// Output:
+	// Some(+1) None None
+}
+
+func ExampleOption_Flatten() {
+	fmt.Println(SomeOf(SomeOf(1)).Flatten(), SomeOf(None).Flatten(), SomeOf(1).Flatten(), None.Flatten())
+	fmt.Println(SomeOf(1, "a").Unzip(), SomeOf(PairOf("k", 1)).Unzip(), None.Unzip())
+
+	// Output:
+	// Some(1) None Some(1) None
+	// (Some(1),Some(a)) (Some(k),Some(1)) (None,None)
+}
+
+func ExampleOption_ToSlice() {
+	fmt.Println(SomeOf(1).OrNull(), None.OrNull())
+	fmt.Println(SomeOf(1).ToSlice(), None.ToSlice().Len())
+	fmt.Println(SomeOf(1).ToLeft("r"), None.ToLeft("r"))
+	fmt.Println(SomeOf(1).ToRight("l"), None.ToRight(func() string { return "l" }))
+	fmt.Println(SomeOf(false).ToTry(nil), None.ToTry(nil), None.ToTry(errors.New("missing")))
+	fmt.Println(None.Empty(), SomeOf(1).Empty())
+
+	// Output:
+	// 1 <nil>
+	// [1] 0
+	// Left(1) Right(r)
+	// Right(1) Left(l)
+	// Success(false) Failure(no such element) Failure(missing)
+	// true false
+}
+
+func ExampleOptionFromPtr() {
+	x := 10
+	var nilPtr *int
+
+	fmt.Println(OptionFromPtr(&x), OptionFromPtr(nilPtr), OptionFromPtr(nil))
+	fmt.Println(OptionFromOk(1, true), OptionFromOk(1, false))
+	fmt.Println(OptionFromZero(1), OptionFromZero(0), OptionFromZero(""), OptionFromZero(test{}), OptionFromZero(nil))
+
+	m := map[string]int{"a": 1}
+	fmt.Println(OptionFromMapLookup(m, "a"), OptionFromMapLookup(m, "b"))
+	fmt.Println(OptionFromErr(1, nil), OptionFromErr(1, errors.New("oops")))
+
+	// Output:
+	// Some(10) None None
+	// Some(1) None
+	// Some(1) None None None None
+	// Some(1) None
+	// Some(1) None
+}
+
+func ExampleOption_ToPtr() {
+	p := SomeOf(10).ToPtr().(*int)
+	fmt.Println(*p, None.ToPtr())
+
+	fmt.Println(SomeOf(1).ToOk())
+	fmt.Println(None.ToOk())
+
+	fmt.Println(SomeOf(1).ToValueErr())
+	fmt.Println(None.ToValueErr())
+
+	// Output:
+	// 10 <nil>
+	// 1 true
+	// <nil> false
+	// 1 <nil>
+	// <nil> no such element
+}
+
+func TestOption_UnzipNotTuple(t *testing.T) {
+	for _, o := range []Option{SomeOf(1), SomeOf("a"), SomeOf([]int{1, 2})} {
+		v := o.Unzip()
+		if v.V1() != None || v.V2() != None {
+			t.Errorf("expect (None,None), but %v", v)
+		}
+	}
+}
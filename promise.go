@@ -31,7 +31,10 @@ func (p *Promise) Complete(result Try) *Promise {
 		return p
 	}
 
-	p.future.in <- result
+	select {
+	case p.future.in <- result:
+	case <-p.future.done:
+	}
 
 	return p
 }
@@ -68,7 +71,7 @@ func (p *Promise) CompleteWith(f Future) *Promise {
 	}
 
 	f.OnComplete(func(v Try) {
-		p.future.in <- v
+		p.Complete(v)
 	})
 
 	return p
@@ -98,8 +101,11 @@ func init() {
 			completed: true,
 			val:       SuccessOf(unit),
 			mux:       &sync.Mutex{},
+			done:      make(chan struct{}),
 		},
 	}
 
+	close(unitPromise.done)
+
 	unitPromise.ctx, unitPromise.cancel = context.WithCancel(context.Background())
 }
@@ -0,0 +1,127 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Reader represents cats-like Reader[E, A], a computation depending on an environment E.
+type Reader interface {
+	fmt.Stringer
+
+	// Run runs this with environment env.
+	// returns value with type A.
+	Run(env interface{}) interface{}
+
+	// Map applies f to the result of this.
+	// f: func(A) B
+	// returns Reader[E, B]
+	Map(f interface{}) Reader
+
+	// FlatMap binds the function f across the result of this.
+	// The new Reader runs with the same environment.
+	// f: func(A) Reader
+	FlatMap(f interface{}) Reader
+
+	// Local returns a Reader running this with the environment modified by f.
+	// f: func(E2) E
+	// returns Reader[E2, A]
+	Local(f interface{}) Reader
+
+	// run runs this with reflect.Value of environment.
+	run(env reflect.Value) reflect.Value
+}
+
+type reader struct {
+	f func(reflect.Value) reflect.Value
+}
+
+var _ Reader = &reader{}
+
+func (r *reader) String() string {
+	return "Reader"
+}
+
+func (r *reader) run(env reflect.Value) reflect.Value {
+	return r.f(env)
+}
+
+func (r *reader) Run(env interface{}) interface{} {
+	return r.run(valueOf(env)).Interface()
+}
+
+func (r *reader) Map(f interface{}) Reader {
+	fw := funcOf(f)
+	return &reader{
+		f: func(env reflect.Value) reflect.Value {
+			return fw.call(r.run(env))
+		},
+	}
+}
+
+func (r *reader) FlatMap(f interface{}) Reader {
+	fw := funcOf(f)
+	return &reader{
+		f: func(env reflect.Value) reflect.Value {
+			return fw.call(r.run(env)).Interface().(Reader).run(env)
+		},
+	}
+}
+
+func (r *reader) Local(f interface{}) Reader {
+	fw := funcOf(f)
+	return &reader{
+		f: func(env reflect.Value) reflect.Value {
+			return r.run(fw.call(env))
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// ReaderOf returns a Reader.
+// f: func(E) A
+func ReaderOf(f interface{}) Reader {
+	fw := funcOf(f)
+	return &reader{
+		f: func(env reflect.Value) reflect.Value {
+			return fw.call(env)
+		},
+	}
+}
+
+// AskReader returns a Reader returning the environment.
+func AskReader() Reader {
+	return &reader{
+		f: func(env reflect.Value) reflect.Value {
+			return env
+		},
+	}
+}
+
+// PureReader returns a Reader returning x and ignoring the environment.
+func PureReader(x interface{}) Reader {
+	xval := valueOf(x)
+	return &reader{
+		f: func(reflect.Value) reflect.Value {
+			return xval
+		},
+	}
+}
+
+// SequenceReader turns a Slice of Reader inside out.
+// x: Go slice or Slice of Reader.
+// returns Reader of Slice with results of all Readers running with the same environment.
+func SequenceReader(x interface{}) Reader {
+	s := seqOf(x)
+
+	return &reader{
+		f: func(env reflect.Value) reflect.Value {
+			var ret reflect.Value
+			for i := 0; i < s.len; i++ {
+				ret = widenAppendSlice(ret, s.v.Index(i).Interface().(Reader).run(env))
+			}
+			return reflect.ValueOf(SliceOf(ret))
+		},
+	}
+}
@@ -0,0 +1,52 @@
+package monadgo
+
+import (
+	"fmt"
+)
+
+type config struct {
+	Host string
+	Port int
+}
+
+func ExampleReaderOf() {
+	host := ReaderOf(func(c config) string {
+		return c.Host
+	})
+
+	port := ReaderOf(func(c config) int {
+		return c.Port
+	})
+
+	addr := host.FlatMap(func(h string) Reader {
+		return port.Map(func(p int) string {
+			return fmt.Sprintf("%s:%d", h, p)
+		})
+	})
+
+	printGet(addr.Run(config{"localhost", 8080}))
+
+	local := addr.Local(func(p int) config {
+		return config{"127.0.0.1", p}
+	})
+	printGet(local.Run(80))
+
+	// Output:
+	// localhost:8080, string
+	// 127.0.0.1:80, string
+}
+
+func ExampleSequenceReader() {
+	r := SequenceReader([]Reader{
+		AskReader(),
+		PureReader(10),
+		ReaderOf(func(x int) int {
+			return x * x
+		}),
+	})
+
+	printGet(r.Run(3).(Slice).Get())
+
+	// Output:
+	// [3 10 9], []int
+}
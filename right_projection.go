@@ -0,0 +1,119 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// RightProjection represents scala-like RightProjection[A,B].
+// It mirrors LeftProjection for code written against projections.
+// Results from Map and FlatMap are Either, and E returns the internal Either back.
+type RightProjection interface {
+	Any
+
+	// E return internal Either value.
+	E() Either
+
+	// Exists returns false if Left,
+	// or returns the result of the application of the given function to the Right value.
+	// func(B) bool
+	Exists(f interface{}) bool
+
+	// Filter returns None if this is a Left,
+	// or if the given predicate p does not hold for the right value,
+	// otherwise return Some of the Right.
+	// f: func(B) bool
+	// returns Option[Either]
+	Filter(f interface{}) Option
+
+	// FlatMap binds the given function f across Right.
+	// f: func(B) Either
+	// returns a new Either.
+	FlatMap(f interface{}) Either
+
+	// Forall returns true if Left,
+	// or returns the result of the application of the given function to the Right value.
+	// f: func(B) bool
+	Forall(f interface{}) bool
+
+	// Foreach executes the given side-effecting function f if this is a Right.
+	// f: func(B)
+	Foreach(f interface{})
+
+	// GetOrElse returns the value from this Right,
+	// or z if this is a Left.
+	GetOrElse(z interface{}) interface{}
+
+	// Map applies f through Right.
+	// f: func(B) X
+	// returns Either[A, X]
+	Map(f interface{}) Either
+
+	// ToOption returns a Some containing the Right value if it exists,
+	// or a None if this is a Left.
+	ToOption() Option
+}
+
+type rightProjection struct {
+	e *traitEither
+}
+
+var _ RightProjection = &rightProjection{}
+
+func (p *rightProjection) Get() interface{} {
+	if p.e.IsLeft() {
+		return nothing
+	}
+	return p.e.Get()
+}
+
+func (p *rightProjection) rv() reflect.Value {
+	return p.e.v
+}
+
+func (p *rightProjection) String() string {
+	return fmt.Sprintf("RightProjection(%v)", p.Get())
+}
+
+func (p *rightProjection) E() Either {
+	return p.e
+}
+
+func (p *rightProjection) Exists(f interface{}) bool {
+	return p.e.Exists(f)
+}
+
+func (p *rightProjection) Filter(f interface{}) Option {
+	if p.e.IsLeft() {
+		return None
+	}
+	if funcOf(f).call(p.e.v).Bool() {
+		return OptionOf(p.E())
+	}
+
+	return None
+}
+
+func (p *rightProjection) FlatMap(f interface{}) Either {
+	return p.e.FlatMap(f)
+}
+
+func (p *rightProjection) Forall(f interface{}) bool {
+	return p.e.Forall(f)
+}
+
+func (p *rightProjection) Foreach(f interface{}) {
+	p.e.Foreach(f)
+}
+
+func (p *rightProjection) GetOrElse(z interface{}) interface{} {
+	return p.e.GetOrElse(z)
+}
+
+func (p *rightProjection) Map(f interface{}) Either {
+	return p.e.Map(f)
+}
+
+func (p *rightProjection) ToOption() Option {
+	return p.e.ToOption()
+}
@@ -0,0 +1,65 @@
+package monadgo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRightProjection(t *testing.T) {
+	e := RightOf(1)
+	if e.Right().E() != e {
+		t.Errorf("prjection faiure for Right")
+	}
+
+	e = LeftOf(fmt.Errorf("error"))
+	if e.Right().E() != e {
+		t.Errorf("prjection faiure for Left")
+	}
+}
+
+func ExampleRightProjection_Filter() {
+	positive := func(x int) bool {
+		return x > 0
+	}
+
+	fmt.Println(RightOf(1).Right().Filter(positive))
+	fmt.Println(RightOf(-1).Right().Filter(positive))
+	fmt.Println(LeftOf("a").Right().Filter(positive))
+
+	fmt.Println(LeftOf(1).Left().Filter(positive))
+
+	// Output:
+	// Some(Right(1))
+	// None
+	// None
+	// Some(Left(1))
+}
+
+func ExampleRightProjection_Map() {
+	double := func(x int) int {
+		return x * 2
+	}
+
+	fmt.Println(RightOf(1).Right().Map(double), LeftOf(1).Right().Map(double))
+	fmt.Println(RightOf(1).Right(), LeftOf(1).Right())
+	fmt.Println(RightOf(1).Right().GetOrElse(0), LeftOf(1).Right().GetOrElse(0))
+	fmt.Println(RightOf(1).Right().ToOption(), LeftOf(1).Right().ToOption())
+	fmt.Println(RightOf(1).Right().Exists(func(x int) bool { return x == 1 }), LeftOf(1).Right().Forall(func(x int) bool { return false }))
+
+	RightOf(1).Right().Foreach(func(x int) {
+		fmt.Println(x)
+	})
+
+	fmt.Println(RightOf(1).Right().FlatMap(func(x int) Either {
+		return LeftOf(x)
+	}))
+
+	// Output:
+	// Right(2) Left(1)
+	// RightProjection(1) RightProjection(Nothing)
+	// 1 0
+	// Some(1) None
+	// true true
+	// 1
+	// Left(1)
+}
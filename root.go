@@ -5,6 +5,9 @@ import "reflect"
 var (
 	typeValues = []reflect.Type{reflect.TypeOf(reflect.Value{})}
 	typeError  = reflect.TypeOf((*error)(nil)).Elem()
+
+	typeInterface = reflect.TypeOf((*interface{})(nil)).Elem()
+	typeInt       = reflect.TypeOf(int(0))
 )
 
 var (
@@ -37,5 +40,7 @@ var (
 
 	typePair = reflect.TypeOf(Pair{})
 
+	typeOption = reflect.TypeOf((*Option)(nil)).Elem()
+
 	typeTuples = []reflect.Type{typeTuple2, typeTuple3, typeTuple4, typeTuple}
 )
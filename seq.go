@@ -1,6 +1,7 @@
 package monadgo
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -219,7 +220,8 @@ func (s seq) Fold(z, f interface{}) interface{} {
 	return zval.Interface()
 }
 
-// Head returns the first element.
+// Head returns the first element, or nil if this is empty.
+// Use HeadOption to distinguish empty from nil element.
 func (s seq) Head() interface{} {
 	if s.len <= 0 {
 		return nil
@@ -246,6 +248,7 @@ func (s seq) Tail() Traversable {
 }
 
 // Reduce reduces the elements of this using the specified associative binary operator.
+// It panics if this is empty, use ReduceOption instead.
 // f: func(T, T) T
 // returns value with type T.
 func (s seq) Reduce(f interface{}) interface{} {
@@ -253,8 +256,33 @@ func (s seq) Reduce(f interface{}) interface{} {
 		panic("empty list can not reduce")
 	}
 
+	return s.reduceLeft(f).Interface()
+}
+
+// ReduceOption reduces the elements of this using the specified associative binary operator,
+// or returns None if this is empty.
+// f: func(T, T) T
+// returns Option of value with type T.
+func (s seq) ReduceOption(f interface{}) Option {
+	return s.ReduceLeftOption(f)
+}
+
+// ReduceLeftOption reduces the elements of this going left to right using the specified binary operator,
+// or returns None if this is empty.
+// f: func(T, T) T
+// returns Option of value with type T.
+func (s seq) ReduceLeftOption(f interface{}) Option {
+	if s.len <= 0 {
+		return None
+	}
+
+	return OptionOf(s.reduceLeft(f))
+}
+
+// reduceLeft reduces non-empty s going left to right.
+func (s seq) reduceLeft(f interface{}) reflect.Value {
 	if s.len == 1 {
-		return s.v.Index(0).Interface()
+		return s.v.Index(0)
 	}
 
 	fw := foldOf(f)
@@ -264,7 +292,7 @@ func (s seq) Reduce(f interface{}) interface{} {
 		zval = fw.call(zval, s.v.Index(i))
 	}
 
-	return zval.Interface()
+	return zval
 }
 
 // Scan computes a prefix scan of the elements of the collection.
@@ -287,23 +315,136 @@ func (s seq) Scan(z, f interface{}) Traversable {
 }
 
 // GroupBy returns Map with K -> Go slice. Key is the result of f. Collect elements into a slice with same resulting key value.
+// Returns an empty Map if this is empty.
 // f: func(T) K
 // returns Map(K -> Go slice)
 func (s seq) GroupBy(f interface{}) Map {
-	if s.len <= 0 {
-		panic("can not group by on empty slice")
-	}
 	fw := funcOf(f)
 	m := makeMap(fw.out[0], s.t, -1)
+	idx := make(keyIndex)
 
 	for i := 0; i < s.len; i++ {
-		k := fw.call(s.v.Index(i))
+		k := idx.add(fw.call(s.v.Index(i)))
 		m.SetMapIndex(k, appendSlice(m.MapIndex(k), s.v.Index(i)))
 	}
 
 	return newMap(m)
 }
 
+// GroupMap returns Map with K -> Go slice. Key is the result of key, and value is the result of value.
+// Collect values into a slice with same resulting key value.
+// key: func(T) K
+// value: func(T) V
+// returns Map(K -> Go slice of V)
+func (s seq) GroupMap(key, value interface{}) Map {
+	kw := funcOf(key)
+	vw := funcOf(value)
+	m := makeMap(kw.out[0], reflect.SliceOf(vw.out[0]), -1)
+	idx := make(keyIndex)
+
+	for i := 0; i < s.len; i++ {
+		x := s.v.Index(i)
+		k := idx.add(kw.call(x))
+		vs := m.MapIndex(k)
+		if !vs.IsValid() {
+			vs = makeSlice(vw.out[0])
+		}
+		m.SetMapIndex(k, reflect.Append(vs, vw.call(x)))
+	}
+
+	return newMap(m)
+}
+
+// GroupMapReduce returns Map with K -> V. Key is the result of key, and value is the result of value.
+// Values with same resulting key value are reduced by reduce.
+// key: func(T) K
+// value: func(T) V
+// reduce: func(V, V) V
+// returns Map(K -> V)
+func (s seq) GroupMapReduce(key, value, reduce interface{}) Map {
+	kw := funcOf(key)
+	vw := funcOf(value)
+	rw := foldOf(reduce)
+	m := makeMap(kw.out[0], vw.out[0], -1)
+	idx := make(keyIndex)
+
+	for i := 0; i < s.len; i++ {
+		x := s.v.Index(i)
+		k := idx.add(kw.call(x))
+		v := vw.call(x)
+		if z := m.MapIndex(k); z.IsValid() {
+			v = rw.call(z, v)
+		}
+		m.SetMapIndex(k, v)
+	}
+
+	return newMap(m)
+}
+
+// CountBy returns Map with K -> int. Key is the result of f, and value is the number of elements with same resulting key value.
+// f: func(T) K
+// returns Map(K -> int)
+func (s seq) CountBy(f interface{}) Map {
+	fw := funcOf(f)
+	m := makeMap(fw.out[0], typeInt, -1)
+	idx := make(keyIndex)
+
+	for i := 0; i < s.len; i++ {
+		k := idx.add(fw.call(s.v.Index(i)))
+		count := 1
+		if z := m.MapIndex(k); z.IsValid() {
+			count += int(z.Int())
+		}
+		m.SetMapIndex(k, reflect.ValueOf(count))
+	}
+
+	return newMap(m)
+}
+
+// PartitionMap applies f to all elements and splits results into a pair of Lefts and Rights.
+// Results of func(T) (X, error) are Right of X or Left of error,
+// and Go slices have element type error and X even if they are empty.
+// Results of func(T) Either have no static types, so that element type of Go slice is
+// the type of first value on each side, interface{} if values have different types,
+// or Nothing if the side is empty.
+// f: func(T) Either, func(T) (X, error) or func(T) (X, bool)
+// returns Tuple2(Go slice of Left values, Go slice of Right values)
+func (s seq) PartitionMap(f interface{}) Tuple2 {
+	var left, right reflect.Value
+
+	ftyp := reflect.TypeOf(f)
+	if ftyp.NumOut() == 2 && (ftyp.Out(1) == typeError || ftyp.Out(1).Kind() == reflect.Bool) {
+		left = makeSlice(ftyp.Out(1), 0, 0)
+		right = makeSlice(ftyp.Out(0), 0, s.len)
+	}
+
+	fw := funcOf(f)
+
+	for i := 0; i < s.len; i++ {
+		result := fw.call(s.v.Index(i)).Interface()
+		e, ok := result.(Either)
+		if !ok {
+			e = tryCBF(result).ToEither()
+		}
+
+		if e.IsRight() {
+			right = widenAppendSlice(right, e.rv())
+		} else {
+			left = widenAppendSlice(left, e.rv())
+		}
+	}
+
+	if !left.IsValid() {
+		left = nothingsValue
+	}
+
+	if !right.IsValid() {
+		right = nothingsValue
+	}
+
+	return Tuple2Of(left.Interface(), right.Interface())
+}
+
 // Take returns the first n elements.
 func (s seq) Take(n int) Traversable {
 	if n >= s.len {
@@ -502,3 +643,249 @@ func (s seq) Collect(pf PartialFunc) Traversable {
 
 	return seqFromValue(ret)
 }
+
+// ----------------------------------------------------------------------------
+
+// tryElemType returns the type of Success value from results of function type ftyp.
+// Last result is dropped if it is error or bool.
+func tryElemType(ftyp reflect.Type) reflect.Type {
+	n := ftyp.NumOut()
+	if n > 0 && (ftyp.Out(n-1) == typeError || ftyp.Out(n-1).Kind() == reflect.Bool) {
+		n--
+	}
+
+	switch n {
+	case 0:
+		return typeUnit
+	case 1:
+		return ftyp.Out(0)
+	default:
+		return typeTuple
+	}
+}
+
+// tryValueOf returns reflect.Value of Success value x with type t.
+// x keeps its dynamic type if t is an interface, like Try.
+// It returns an error if x is not assignable to t.
+func tryValueOf(t reflect.Type, x interface{}) (reflect.Value, error) {
+	if t == typeUnit {
+		return unitValue, nil
+	}
+
+	if t.Kind() == reflect.Interface {
+		return valueOf(x), nil
+	}
+
+	if x == nil || x == null {
+		switch t.Kind() {
+		case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map, reflect.Func, reflect.Chan:
+			return reflect.Zero(t), nil
+		}
+		return reflect.Value{}, fmt.Errorf("nil is not assignable to %v", t)
+	}
+
+	if !reflect.TypeOf(x).AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("%T is not assignable to %v", x, t)
+	}
+	return reflect.ValueOf(x), nil
+}
+
+// tryEach applies f to all elements and passes Success values to g.
+// An error from g is a Failure.
+// It stops at the first Failure if mode is FailFast,
+// or collects all Failures into Errors if mode is CollectErrors.
+func (s seq) tryEach(f interface{}, mode []ErrorMode, g func(interface{}) error) Try {
+	var errs Errors
+
+	fw := funcOf(f)
+	for i := 0; i < s.len; i++ {
+		result := tryCBF(fw.call(s.v.Index(i)))
+		if result.OK() {
+			err := g(result.Get())
+			if err == nil {
+				continue
+			}
+			result = newTraitTry(false, err)
+		}
+
+		if errorModeOf(mode) == FailFast {
+			return result
+		}
+		errs = appendErrors(errs, errorOf(result.Get()))
+	}
+
+	if len(errs) > 0 {
+		return newTraitTry(false, errs)
+	}
+
+	return successUnit
+}
+
+// MapE applies function f to all elements in seq s.
+// Results of f are converted to Try like TryOf.
+// f: func(T) (X, error), func(T) (X, bool) or func(T) Try
+// returns Success of Traversable with element type X,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+// Element type is the type of Success values if X is an interface, like Try.
+func (s seq) MapE(f interface{}, mode ...ErrorMode) Try {
+	elm := tryElemType(reflect.TypeOf(f))
+
+	var ret reflect.Value
+	if elm.Kind() != reflect.Interface {
+		ret = makeSlice(elm, 0, s.len)
+	}
+
+	result := s.tryEach(f, mode, func(x interface{}) error {
+		v, err := tryValueOf(elm, x)
+		if err != nil {
+			return err
+		}
+		ret = widenAppendSlice(ret, v)
+		return nil
+	})
+
+	if result.Failed() {
+		return result
+	}
+
+	return SuccessOf(seqFromValue(ret))
+}
+
+// FlatMapE applies f to all elements and builds a new Travesable from result.
+// Results of f are converted to Try like TryOf.
+// f: func(T) (X, error), func(T) (X, bool) or func(T) Try, X can be Go slice, or map.
+// returns Success of Traversable,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+// Element type is from types of Success values if X is an interface, like Try.
+func (s seq) FlatMapE(f interface{}, mode ...ErrorMode) Try {
+	xtyp := tryElemType(reflect.TypeOf(f))
+	dynamic := xtyp.Kind() == reflect.Interface
+
+	var ret reflect.Value
+	switch {
+	case dynamic:
+	case xtyp.Kind() == reflect.Slice:
+		ret = makeSlice(xtyp.Elem(), 0, 0)
+	case xtyp.Kind() == reflect.Map:
+		ret = makeSlice(typePair, 0, 0)
+	default:
+		ret = makeSlice(xtyp, 0, 0)
+	}
+
+	result := s.tryEach(f, mode, func(x interface{}) error {
+		v, err := tryValueOf(xtyp, x)
+		if err != nil {
+			return err
+		}
+
+		if !dynamic {
+			ret = mergeSlice(ret, seqFromValue(v).v)
+			return nil
+		}
+
+		elems := seqFromValue(v).v
+		for i := 0; i < elems.Len(); i++ {
+			ret = widenAppendSlice(ret, elems.Index(i))
+		}
+		return nil
+	})
+
+	if result.Failed() {
+		return result
+	}
+
+	return SuccessOf(seqFromValue(ret))
+}
+
+// FoldE folds the elements using specified binary operator.
+// Results of f are converted to Try like TryOf.
+// Failed steps are skipped if mode is CollectErrors.
+// z: func() Z or value of type Z.
+// f: func(Z, T) (Z, error) or func(Z, T) (Z, bool)
+// returns Success of value with type Z,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+// returns Failure if z is nil, because type Z is unknown.
+func (s seq) FoldE(z, f interface{}, mode ...ErrorMode) Try {
+	var errs Errors
+
+	zval := reflect.ValueOf(checkAndInvoke(z))
+	if !zval.IsValid() {
+		return newTraitTry(false, errors.New("zero value of FoldE is nil"))
+	}
+	fw := foldOf(f)
+
+	for i := 0; i < s.len; i++ {
+		result := tryCBF(fw.call(zval, s.v.Index(i)))
+		if result.OK() {
+			v, err := tryValueOf(zval.Type(), result.Get())
+			if err == nil {
+				zval = v
+				continue
+			}
+			result = newTraitTry(false, err)
+		}
+
+		if errorModeOf(mode) == FailFast {
+			return result
+		}
+		errs = appendErrors(errs, errorOf(result.Get()))
+	}
+
+	if len(errs) > 0 {
+		return newTraitTry(false, errs)
+	}
+
+	return SuccessOf(zval.Interface())
+}
+
+// ForeachE applies f to all element.
+// Results of f are converted to Try like TryOf.
+// f: func(T) error or func(T) bool
+// returns Success of Unit,
+// or the first Failure, or Failure of Errors if mode is CollectErrors.
+func (s seq) ForeachE(f interface{}, mode ...ErrorMode) Try {
+	return s.tryEach(f, mode, func(interface{}) error {
+		return nil
+	})
+}
+
+// Equal reports whether this and other have deeply equal elements, like Equal.
+func (s seq) Equal(other Any) bool {
+	return Equal(s, other)
+}
+
+// Hash returns the hash of elements, consistent with Equal.
+func (s seq) Hash() uint64 {
+	return Hash(s)
+}
+
+// Compare compares elements of this and other lexicographically, like Compare.
+func (s seq) Compare(other Slice) int {
+	return Compare(s, other)
+}
+
+// Distinct returns elements without duplicates by Equal, keeping the first occurrence.
+func (s seq) Distinct() Traversable {
+	ret := reflect.MakeSlice(s.t, 0, 0)
+	buckets := make(map[uint64][]reflect.Value)
+
+	for i := 0; i < s.len; i++ {
+		x := s.v.Index(i)
+		h := hashValue(x)
+
+		dup := false
+		for _, y := range buckets[h] {
+			if equalValue(x, y) {
+				dup = true
+				break
+			}
+		}
+
+		if !dup {
+			buckets[h] = append(buckets[h], x)
+			ret = appendSlice(ret, x)
+		}
+	}
+
+	return seqFromValue(ret)
+}
@@ -0,0 +1,206 @@
+package monadgo
+
+import (
+	"context"
+	"reflect"
+)
+
+// traverseSlice returns an empty Go slice with element type X from results of f,
+// where f: func(T) (X, error) or func(T) (X, bool).
+// It returns an invalid reflect.Value if X is an interface, like Option, Try, Either and Future,
+// and then element type is decided by traverseAppend.
+func traverseSlice(f interface{}, n int) (reflect.Value, reflect.Type) {
+	elm := tryElemType(reflect.TypeOf(f))
+	if elm.Kind() == reflect.Interface {
+		return reflect.Value{}, elm
+	}
+	return makeSlice(elm, 0, n), elm
+}
+
+// traverseAppend appends x to ret with element type elm.
+// Element type of ret is the type of the first x if elm is an interface,
+// and ret is widen to []interface{} if types of x are different.
+func traverseAppend(ret reflect.Value, elm reflect.Type, x interface{}) reflect.Value {
+	v, err := tryValueOf(elm, x)
+	if err != nil {
+		v = valueOf(x)
+	}
+	return widenAppendSlice(ret, v)
+}
+
+// SequenceOption turns a Slice of Option inside out.
+// Element type of the result is the type of values in Options, []interface{} if they are different,
+// or Nothing if x is empty.
+// x: Go slice or Slice of Option.
+// returns Some of Slice with all values, or None if any element is None.
+func SequenceOption(x interface{}) Option {
+	return TraverseOption(x, func(o Option) Option {
+		return o
+	})
+}
+
+// TraverseOption applies f to all elements, and turns results inside out.
+// Results of func(T) (X, error) or func(T) (X, bool) are None if failed, and built into Slice with element type X.
+// Results of func(T) Option are built like SequenceOption.
+// f: func(T) Option, func(T) (X, error) or func(T) (X, bool)
+// returns Some of Slice with all values, or the first None.
+func TraverseOption(x, f interface{}) Option {
+	s := seqOf(x)
+	fw := funcOf(f)
+	ret, elm := traverseSlice(f, s.len)
+
+	for i := 0; i < s.len; i++ {
+		result := fw.call(s.v.Index(i)).Interface()
+		o, ok := result.(Option)
+		if !ok {
+			o = tryCBF(result).ToOption()
+		}
+
+		if !o.Defined() {
+			return None
+		}
+		ret = traverseAppend(ret, elm, o.Get())
+	}
+
+	return SomeOf(SliceOf(ret))
+}
+
+// SequenceTry turns a Slice of Try inside out.
+// Element type of the result is the type of Success values, []interface{} if they are different,
+// or Nothing if x is empty.
+// x: Go slice or Slice of Try.
+// returns Success of Slice with all values, or the first Failure.
+func SequenceTry(x interface{}) Try {
+	return TraverseTry(x, func(t Try) Try {
+		return t
+	})
+}
+
+// TraverseTry applies f to all elements, and turns results inside out.
+// Results of f are converted to Try like TryOf.
+// Results of func(T) (X, error) or func(T) (X, bool) are built into Slice with element type X,
+// and results of func(T) Try are built like SequenceTry.
+// f: func(T) Try, func(T) (X, error) or func(T) (X, bool)
+// returns Success of Slice with all values, or the first Failure.
+func TraverseTry(x, f interface{}) Try {
+	s := seqOf(x)
+	fw := funcOf(f)
+	ret, elm := traverseSlice(f, s.len)
+
+	for i := 0; i < s.len; i++ {
+		t := tryCBF(fw.call(s.v.Index(i)))
+		if t.Failed() {
+			return t
+		}
+		ret = traverseAppend(ret, elm, t.Get())
+	}
+
+	return SuccessOf(SliceOf(ret))
+}
+
+// SequenceEither turns a Slice of Either inside out.
+// Element type of the result is the type of Right values, []interface{} if they are different,
+// or Nothing if x is empty.
+// x: Go slice or Slice of Either.
+// returns Right of Slice with all Right values, or the first Left.
+func SequenceEither(x interface{}) Either {
+	return TraverseEither(x, func(e Either) Either {
+		return e
+	})
+}
+
+// TraverseEither applies f to all elements, and turns results inside out.
+// Results of func(T) (X, error) or func(T) (X, bool) are Left of the error or false if failed,
+// and built into Slice with element type X.
+// Results of func(T) Either are built like SequenceEither.
+// f: func(T) Either, func(T) (X, error) or func(T) (X, bool)
+// returns Right of Slice with all Right values, or the first Left.
+func TraverseEither(x, f interface{}) Either {
+	s := seqOf(x)
+	fw := funcOf(f)
+	ret, elm := traverseSlice(f, s.len)
+
+	for i := 0; i < s.len; i++ {
+		result := fw.call(s.v.Index(i)).Interface()
+		e, ok := result.(Either)
+		if !ok {
+			e = tryCBF(result).ToEither()
+		}
+
+		if e.IsLeft() {
+			return e
+		}
+		ret = traverseAppend(ret, elm, e.Get())
+	}
+
+	return RightOf(SliceOf(ret))
+}
+
+// SequenceFuture turns a Slice of Future inside out.
+// Element type of the result is the type of Success values, []interface{} if they are different,
+// or Nothing if x is empty.
+// The returned Future fails as soon as any Future fails.
+// Canceling the returned Future cancels all Futures in x which are not completed.
+// x: Go slice or Slice of Future.
+// returns Future of Slice with all values.
+func SequenceFuture(x interface{}) Future {
+	return TraverseFuture(x, func(u Future) Future {
+		return u
+	})
+}
+
+// TraverseFuture applies f to all elements, and turns results inside out.
+// The returned Future fails as soon as any Future from f fails.
+// Canceling the returned Future cancels all Futures from f which are not completed.
+// f: func(T) Future
+// returns Future of Slice with all values.
+func TraverseFuture(x, f interface{}) Future {
+	s := seqOf(x)
+	fw := funcOf(f)
+
+	futures := make([]*future, s.len)
+	for i := 0; i < s.len; i++ {
+		futures[i] = futureOf(fw.call(s.v.Index(i)).Interface().(Future))
+	}
+
+	p := DefaultPromise(context.Background())
+
+	go func() {
+		idx := make(chan int, len(futures))
+		for i, u := range futures {
+			go func(i int, u *future) {
+				select {
+				case <-u.done:
+					idx <- i
+				case <-p.done:
+				}
+			}(i, u)
+		}
+
+		results := make([]Try, len(futures))
+		for remain := len(futures); remain > 0; remain-- {
+			select {
+			case i := <-idx:
+				v, _ := futures[i].result()
+				if v.Failed() {
+					p.Complete(v)
+					return
+				}
+				results[i] = v
+			case <-p.done:
+				for _, u := range futures {
+					u.Cancel()
+				}
+				return
+			}
+		}
+
+		var ret reflect.Value
+		for _, v := range results {
+			ret = traverseAppend(ret, typeInterface, v.Get())
+		}
+		p.Success(SliceOf(ret))
+	}()
+
+	return p
+}
@@ -0,0 +1,211 @@
+package monadgo
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func ExampleSequenceOption() {
+	o := SequenceOption([]Option{SomeOf(1), SomeOf(2), SomeOf(3)})
+	fmt.Println(o)
+	printGet(o.Get().(Slice).Get())
+
+	o = SequenceOption([]Option{SomeOf(1), None, SomeOf(3)})
+	fmt.Println(o)
+
+	o = SequenceOption([]Option{})
+	printGet(o.Get().(Slice).Get())
+
+	// Output:
+	// Some([1 2 3])
+	// [1 2 3], []int
+	// None
+	// [], []monadgo.Nothing
+}
+
+func ExampleTraverseOption() {
+	half := func(x int) Option {
+		if x%2 == 0 {
+			return SomeOf(x / 2)
+		}
+		return None
+	}
+
+	fmt.Println(TraverseOption([]int{2, 4, 6}, half))
+	fmt.Println(TraverseOption([]int{2, 3, 6}, half))
+
+	// Output:
+	// Some([1 2 3])
+	// None
+}
+
+func ExampleSequenceTry() {
+	t := SequenceTry([]Try{SuccessOf(1), SuccessOf(2)})
+	fmt.Println(t)
+
+	t = SequenceTry([]Try{SuccessOf(1), FailureOf(fmt.Errorf("e1")), FailureOf(fmt.Errorf("e2"))})
+	fmt.Println(t)
+
+	// Output:
+	// Success([1 2])
+	// Failure(e1)
+}
+
+func ExampleTraverseTry() {
+	t := TraverseTry([]string{"1", "2"}, func(x string) Try {
+		return TryOf(strconv.Atoi(x))
+	})
+	printGet(t.Get().(Slice).Get())
+
+	// Output:
+	// [1 2], []int
+}
+
+func ExampleSequenceEither() {
+	e := SequenceEither([]Either{RightOf("a"), RightOf("b")})
+	fmt.Println(e)
+
+	e = SequenceEither([]Either{RightOf("a"), LeftOf(1), LeftOf(2)})
+	fmt.Println(e)
+
+	// Output:
+	// Right([a b])
+	// Left(1)
+}
+
+func ExampleTraverseEither() {
+	e := TraverseEither([]int{1, 2}, func(x int) Either {
+		return RightOf(x * 10)
+	})
+	fmt.Println(e)
+
+	// Output:
+	// Right([10 20])
+}
+
+func TestSequenceFuture(t *testing.T) {
+	f := SequenceFuture([]Future{
+		FutureOf(func() int {
+			time.Sleep(100 * time.Millisecond)
+			return 1
+		}),
+		FutureOf(func() int {
+			return 2
+		}),
+	})
+
+	x := f.Result(wait)
+	if !x.Defined() {
+		t.Fatalf("expect Some, but %v", x)
+	}
+
+	if s := x.Get().(Slice).Get().([]int); len(s) != 2 || s[0] != 1 || s[1] != 2 {
+		t.Errorf("expect [1 2], but %v", s)
+	}
+
+	if x := SequenceFuture([]Future{}).Result(wait); x.Get().(Slice).Size() != 0 {
+		t.Errorf("expect empty slice, but %v", x)
+	}
+}
+
+func TestSequenceFuture_Failure(t *testing.T) {
+	f := TraverseFuture([]int{1, 2}, func(x int) Future {
+		return FutureOf(func() (int, error) {
+			if x == 1 {
+				sleep(10)
+				return x, nil
+			}
+			return 0, fmt.Errorf("error %d", x)
+		})
+	})
+
+	x := f.Ready(wait)
+	if !x.Defined() {
+		t.Fatalf("expect completed without waiting slow future")
+	}
+
+	if f.Value().Defined() {
+		t.Errorf("expect failure, but %v", f)
+	}
+}
+
+func TestSequenceFuture_Cancel(t *testing.T) {
+	f1 := FutureOf(func() int {
+		sleep(10)
+		return 1
+	})
+
+	f2 := f1.Map(func(x int) int {
+		return x * 10
+	})
+
+	f := SequenceFuture([]Future{f2})
+	f.Cancel()
+
+	if !f.Ready(wait).Defined() || f.Value().Defined() {
+		t.Errorf("f should be canceled, but %v", f)
+	}
+
+	if !f2.Ready(wait).Defined() || f2.Value().Defined() {
+		t.Errorf("f2 should be canceled, but %v", f2)
+	}
+}
+
+func TestTraverse_Typed(t *testing.T) {
+	lookup := func(k string) (int, bool) {
+		v, ok := map[string]int{"a": 1, "b": 2}[k]
+		return v, ok
+	}
+
+	if o := TraverseOption([]string{"a", "b"}, lookup); fmt.Sprintf("%T", o.Get().(Slice).Get()) != "[]int" {
+		t.Errorf("expect Some of []int, but %v", o)
+	}
+	if o := TraverseOption([]string{"a", "c"}, lookup); o.Defined() {
+		t.Errorf("expect None, but %v", o)
+	}
+
+	for _, x := range [][]string{{"1", "2"}, {}} {
+		r := TraverseTry(x, strconv.Atoi)
+		if s, ok := r.Get().(Slice).Get().([]int); !ok || len(s) != len(x) {
+			t.Errorf("expect Success of []int with %d elements, but %v", len(x), r)
+		}
+
+		e := TraverseEither(x, strconv.Atoi)
+		if s, ok := e.Get().(Slice).Get().([]int); !ok || len(s) != len(x) {
+			t.Errorf("expect Right of []int with %d elements, but %v", len(x), e)
+		}
+
+	}
+
+	if o := TraverseOption([]string{}, lookup); fmt.Sprintf("%T", o.Get().(Slice).Get()) != "[]int" {
+		t.Errorf("expect Some of empty []int, but %v", o)
+	}
+
+	if r := TraverseTry([]string{"1", "a"}, strconv.Atoi); r.OK() {
+		t.Errorf("expect Failure, but %v", r)
+	}
+	if e := TraverseEither([]string{"1", "a"}, strconv.Atoi); !e.IsLeft() {
+		t.Errorf("expect Left, but %v", e)
+	}
+
+	if o := SequenceOption([]Option{}); fmt.Sprintf("%T", o.Get().(Slice).Get()) != "[]monadgo.Nothing" {
+		t.Errorf("expect Some of []Nothing, but %v", o)
+	}
+	if o := SequenceOption([]Option{SomeOf(1), SomeOf("a")}); fmt.Sprintf("%T", o.Get().(Slice).Get()) != "[]interface {}" {
+		t.Errorf("expect Some of []interface{}, but %v", o)
+	}
+}
+
+// wrappedFuture is a Future implemented outside of this package.
+type wrappedFuture struct {
+	Future
+}
+
+func TestSequenceFuture_Wrapped(t *testing.T) {
+	f := SequenceFuture([]Future{wrappedFuture{FutureOf(func() int { return 1 })}, FutureOf(func() int { return 2 })})
+	if x := f.Result(wait); fmt.Sprint(x) != "Some([1 2])" {
+		t.Errorf("expect Some([1 2]), but %v", x)
+	}
+}
@@ -26,6 +26,14 @@ type Slice interface {
 	Reverse() Traversable
 
 	Scan(z, f interface{}) Traversable
+
+	// Compare compares elements of this and other lexicographically, like Compare.
+	// returns -1 if this < other, 0 if this == other, and +1 if this > other.
+	Compare(other Slice) int
+
+	// Distinct returns elements without duplicates by Equal, keeping the first occurrence.
+	// Elements can be Option, Either, Try, Tuple and other values which are not comparable in Go.
+	Distinct() Traversable
 }
 
 type slice = seq
@@ -2,6 +2,8 @@ package monadgo
 
 import (
 	"fmt"
+	"strconv"
+	"testing"
 )
 
 func ExampleSliceOf() {
@@ -469,3 +471,298 @@ func ExampleSlice_Collect() {
 	// [16 25], monadgo.seq
 	// [], monadgo.seq
 }
+
+func ExampleSlice_GroupMap() {
+	m := SliceOf([]int{1, 2, 3, 4, 5}).GroupMap(func(x int) bool {
+		return x%2 == 0
+	}, func(x int) string {
+		return fmt.Sprintf("%d", x*10)
+	})
+	printGet(m.Get())
+
+	m = SliceOf([]int{}).GroupMap(func(x int) bool {
+		return x%2 == 0
+	}, func(x int) string {
+		return fmt.Sprintf("%d", x*10)
+	})
+	printGet(m.Get())
+
+	// Output:
+	// map[false:[10 30 50] true:[20 40]], map[bool][]string
+	// map[], map[bool][]string
+}
+
+func ExampleSlice_GroupMapReduce() {
+	m := SliceOf([]string{"a", "bb", "cc", "ddd"}).GroupMapReduce(func(x string) int {
+		return len(x)
+	}, func(x string) string {
+		return x
+	}, func(x, y string) string {
+		return x + y
+	})
+	printGet(m.Get())
+
+	m = SliceOf([]string{}).GroupMapReduce(func(x string) int {
+		return len(x)
+	}, func(x string) string {
+		return x
+	}, func(x, y string) string {
+		return x + y
+	})
+	printGet(m.Get())
+
+	// Output:
+	// map[1:a 2:bbcc 3:ddd], map[int]string
+	// map[], map[int]string
+}
+
+func ExampleSlice_CountBy() {
+	m := SliceOf([]int{1, 2, 3, 4, 5}).CountBy(func(x int) bool {
+		return x%2 == 0
+	})
+	printGet(m.Get())
+
+	m = SliceOf([]int{}).CountBy(func(x int) bool {
+		return x%2 == 0
+	})
+	printGet(m.Get())
+
+	// Output:
+	// map[false:3 true:2], map[bool]int
+	// map[], map[bool]int
+}
+
+func ExampleSlice_PartitionMap() {
+	t := SliceOf([]string{"1", "a", "2", "b"}).PartitionMap(func(x string) Either {
+		if x >= "a" {
+			return LeftOf(x)
+		}
+		return RightOf(len(x))
+	})
+	printGet(t.V1())
+	printGet(t.V2())
+
+	t = SliceOf([]int{1, 2, 3}).PartitionMap(func(x int) Either {
+		if x == 1 {
+			return LeftOf(x)
+		}
+		return LeftOf(fmt.Sprintf("%d", x))
+	})
+	printGet(t.V1())
+	printGet(t.V2())
+
+	t = SliceOf([]int{}).PartitionMap(func(x int) Either {
+		return RightOf(x)
+	})
+	printGet(t.V1())
+	printGet(t.V2())
+
+	// Output:
+	// [a b], []string
+	// [1 1], []int
+	// [1 2 3], []interface {}
+	// [], []monadgo.Nothing
+	// [], []monadgo.Nothing
+	// [], []monadgo.Nothing
+}
+
+func TestSlice_PartitionMap_Typed(t *testing.T) {
+	for _, x := range [][]string{{"1", "a", "2"}, {"1"}, {"a"}, {}} {
+		p := SliceOf(x).PartitionMap(strconv.Atoi)
+		if _, ok := p.V1().([]error); !ok {
+			t.Errorf("expect []error on left of %v, but %T", x, p.V1())
+		}
+		if _, ok := p.V2().([]int); !ok {
+			t.Errorf("expect []int on right of %v, but %T", x, p.V2())
+		}
+	}
+
+	p := SliceOf([]string{"1", "a", "2"}).PartitionMap(strconv.Atoi)
+	if len(p.V1().([]error)) != 1 || fmt.Sprint(p.V2()) != "[1 2]" {
+		t.Errorf("expect 1 error and [1 2], but %v", p)
+	}
+
+	p = SliceOf([]int{}).PartitionMap(func(x int) Either {
+		return RightOf(x)
+	})
+	if _, ok := p.V1().([]Nothing); !ok {
+		t.Errorf("expect []Nothing for empty input of Either callback, but %T", p.V1())
+	}
+	if _, ok := p.V2().([]Nothing); !ok {
+		t.Errorf("expect []Nothing for empty input of Either callback, but %T", p.V2())
+	}
+}
+
+func ExampleSlice_ReduceOption() {
+	sum := SliceOf([]int{1, 2, 3, 4, 5}).ReduceOption(func(x1, x2 int) int {
+		return x1 + x2
+	})
+	fmt.Println(sum)
+
+	sum = SliceOf([]int{}).ReduceLeftOption(func(x1, x2 int) int {
+		return x1 + x2
+	})
+	fmt.Println(sum)
+
+	// Output:
+	// Some(15)
+	// None
+}
+
+func ExampleSlice_GroupBy_empty() {
+	m := SliceOf([]int{}).GroupBy(func(x int) int {
+		return x % 2
+	})
+	printGet(m.Get())
+
+	// Output:
+	// map[], map[int][]int
+}
+
+func ExampleSlice_MapE() {
+	atoi := func(x string) (int, error) {
+		return strconv.Atoi(x)
+	}
+
+	t := SliceOf([]string{"1", "2", "3"}).MapE(atoi)
+	fmt.Println(t)
+	printGet(t.Get().(Slice).Get())
+
+	t = SliceOf([]string{"1", "a", "b"}).MapE(atoi)
+	fmt.Println(t)
+
+	t = SliceOf([]string{"1", "a", "b"}).MapE(atoi, CollectErrors)
+	fmt.Println(t)
+
+	t = SliceOf([]string{"a", "bb"}).MapE(func(x string) (int, bool) {
+		return len(x), len(x) < 2
+	})
+	fmt.Println(t)
+
+	// Output:
+	// Success([1 2 3])
+	// [1 2 3], []int
+	// Failure(strconv.Atoi: parsing "a": invalid syntax)
+	// Failure(strconv.Atoi: parsing "a": invalid syntax; strconv.Atoi: parsing "b": invalid syntax)
+	// Failure(false)
+}
+
+func ExampleSlice_FlatMapE() {
+	t := SliceOf([]int{1, 2}).FlatMapE(func(x int) ([]int, error) {
+		return []int{x, x * 10}, nil
+	})
+	printGet(t.Get().(Slice).Get())
+
+	t = SliceOf([]int{1, 2, 3}).FlatMapE(func(x int) ([]int, error) {
+		if x > 1 {
+			return nil, fmt.Errorf("%d is too big", x)
+		}
+		return []int{x}, nil
+	}, CollectErrors)
+	fmt.Println(t)
+
+	// Output:
+	// [1 10 2 20], []int
+	// Failure(2 is too big; 3 is too big)
+}
+
+func ExampleSlice_FoldE() {
+	add := func(z int, x string) (int, error) {
+		n, err := strconv.Atoi(x)
+		return z + n, err
+	}
+
+	fmt.Println(SliceOf([]string{"1", "2", "3"}).FoldE(0, add))
+	fmt.Println(SliceOf([]string{"1", "a", "3"}).FoldE(0, add))
+
+	// Output:
+	// Success(6)
+	// Failure(strconv.Atoi: parsing "a": invalid syntax)
+}
+
+func ExampleSlice_ForeachE() {
+	t := SliceOf([]int{1, 2, 3}).ForeachE(func(x int) error {
+		fmt.Println(x)
+		if x > 1 {
+			return fmt.Errorf("%d is too big", x)
+		}
+		return nil
+	})
+	fmt.Println(t)
+
+	// Output:
+	// 1
+	// 2
+	// Failure(2 is too big)
+}
+
+func TestSlice_MapE_Try(t *testing.T) {
+	toTry := func(x int) Try {
+		return SuccessOf(strconv.Itoa(x))
+	}
+
+	if r := SliceOf([]int{1, 2, 3}).MapE(toTry); !r.OK() || fmt.Sprint(r) != "Success([1 2 3])" {
+		t.Errorf("expect Success([1 2 3]), but %v", r)
+	} else if _, ok := r.Get().(Slice).Get().([]string); !ok {
+		t.Errorf("expect []string, but %T", r.Get().(Slice).Get())
+	}
+
+	toSlice := func(x int) Try {
+		return SuccessOf([]int{x, x})
+	}
+	if r := SliceOf([]int{1, 2}).FlatMapE(toSlice); fmt.Sprint(r) != "Success([1 1 2 2])" {
+		t.Errorf("expect Success([1 1 2 2]), but %v", r)
+	}
+
+	if r := SliceOf([]int{}).MapE(toTry); !r.OK() || r.Get().(Slice).Len() != 0 {
+		t.Errorf("expect Success of empty Slice, but %v", r)
+	}
+
+	var calls int
+	failOdd := func(x int) Try {
+		calls++
+		if x%2 == 1 {
+			return FailureOf(fmt.Errorf("%d is odd", x))
+		}
+		return SuccessOf(x)
+	}
+
+	if r := SliceOf([]int{1, 2, 3}).MapE(failOdd); r.OK() || r.Get().(error).Error() != "1 is odd" || calls != 1 {
+		t.Errorf("expect Failure(1 is odd) after 1 call, but %v after %d calls", r, calls)
+	}
+
+	calls = 0
+	if r := SliceOf([]int{1, 2, 3}).MapE(failOdd, CollectErrors); r.OK() || len(r.Get().(Errors)) != 2 || calls != 3 {
+		t.Errorf("expect Failure of 2 errors after 3 calls, but %v after %d calls", r, calls)
+	}
+
+	calls = 0
+	if r := SliceOf([]int{1, 2, 3}).FlatMapE(failOdd); r.OK() || calls != 1 {
+		t.Errorf("expect Failure after 1 call, but %v after %d calls", r, calls)
+	}
+
+	calls = 0
+	if r := SliceOf([]int{1, 2, 3}).FlatMapE(failOdd, CollectErrors); r.OK() || len(r.Get().(Errors)) != 2 || calls != 3 {
+		t.Errorf("expect Failure of 2 errors after 3 calls, but %v after %d calls", r, calls)
+	}
+}
+
+func TestSlice_FoldE_TypeMismatch(t *testing.T) {
+	sum := func(z, x int) Try {
+		return SuccessOf(z + x)
+	}
+	if r := SliceOf([]int{1, 2}).FoldE(0, sum); !r.OK() || r.Get() != 3 {
+		t.Errorf("expect Success(3), but %v", r)
+	}
+
+	str := func(z, x int) Try {
+		return SuccessOf(strconv.Itoa(z + x))
+	}
+	if r := SliceOf([]int{1, 2}).FoldE(0, str); r.OK() {
+		t.Errorf("expect Failure, but %v", r)
+	}
+
+	if r := SliceOf([]int{1, 2}).FoldE(nil, sum); r.OK() {
+		t.Errorf("expect Failure, but %v", r)
+	}
+}
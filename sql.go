@@ -0,0 +1,324 @@
+package monadgo
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NullOption wraps Option as sql.Scanner and driver.Valuer for nullable columns.
+// NULL is scanned to None, and None is stored as NULL.
+type NullOption struct {
+	Option
+
+	// Type is the type of values scanned into Some, eg: reflect.TypeOf("").
+	// Values from the driver are kept if Type is nil, and []byte is copied.
+	Type reflect.Type
+}
+
+var (
+	_ sql.Scanner   = &NullOption{}
+	_ driver.Valuer = NullOption{}
+)
+
+// NullOptionOf returns a NullOption of None, scanning values with type t.
+func NullOptionOf(t reflect.Type) *NullOption {
+	return &NullOption{
+		Option: None,
+		Type:   t,
+	}
+}
+
+// Scan implements sql.Scanner.
+func (n *NullOption) Scan(src interface{}) error {
+	if src == nil {
+		n.Option = None
+		return nil
+	}
+
+	v, err := convertScanned(src, n.Type)
+	if err != nil {
+		return err
+	}
+
+	n.Option = &traitOption{empty: false, v: v}
+	return nil
+}
+
+// Value implements driver.Valuer.
+// Some is converted with driver.DefaultParameterConverter.
+func (n NullOption) Value() (driver.Value, error) {
+	if n.Option == nil || n.Option.Empty() {
+		return nil, nil
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(n.Option.Get())
+}
+
+// MarshalJSON encodes n like Option, and NullOption without Option as null.
+func (n NullOption) MarshalJSON() ([]byte, error) {
+	if n.Option == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Option)
+}
+
+// convertScanned converts value src from a driver to type t.
+// src can be int64, float64, bool, []byte, string or time.Time.
+func convertScanned(src interface{}, t reflect.Type) (reflect.Value, error) {
+	if b, ok := src.([]byte); ok {
+		src = append([]byte{}, b...)
+	}
+
+	sv := reflect.ValueOf(src)
+	if t == nil || sv.Type() == t {
+		return sv, nil
+	}
+
+	ret := reflect.New(t).Elem()
+
+	switch t.Kind() {
+	case reflect.String:
+		switch v := src.(type) {
+		case []byte:
+			ret.SetString(string(v))
+		case string:
+			ret.SetString(v)
+		case time.Time:
+			ret.SetString(v.Format(time.RFC3339Nano))
+		default:
+			ret.SetString(fmt.Sprintf("%v", v))
+		}
+		return ret, nil
+
+	case reflect.Bool:
+		b, err := driver.Bool.ConvertValue(src)
+		if err != nil {
+			return ret, fmt.Errorf("cannot scan %T into %v: %w", src, t, err)
+		}
+		ret.SetBool(b.(bool))
+		return ret, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if s, ok := scannedString(src); ok {
+			i, err := strconv.ParseInt(strings.TrimSpace(s), 10, t.Bits())
+			if err != nil {
+				return ret, fmt.Errorf("cannot scan %T into %v: %w", src, t, err)
+			}
+			ret.SetInt(i)
+			return ret, nil
+		}
+
+		if sv.Kind() == reflect.Int64 {
+			if ret.OverflowInt(sv.Int()) {
+				return ret, fmt.Errorf("cannot scan %v into %v: value out of range", src, t)
+			}
+			ret.SetInt(sv.Int())
+			return ret, nil
+		}
+
+		if sv.Kind() == reflect.Float64 {
+			f := sv.Float()
+			if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 || ret.OverflowInt(int64(f)) {
+				return ret, fmt.Errorf("cannot scan %v into %v: not an integer in range", src, t)
+			}
+			ret.SetInt(int64(f))
+			return ret, nil
+		}
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if s, ok := scannedString(src); ok {
+			u, err := strconv.ParseUint(strings.TrimSpace(s), 10, t.Bits())
+			if err != nil {
+				return ret, fmt.Errorf("cannot scan %T into %v: %w", src, t, err)
+			}
+			ret.SetUint(u)
+			return ret, nil
+		}
+
+		if sv.Kind() == reflect.Int64 {
+			if sv.Int() < 0 || ret.OverflowUint(uint64(sv.Int())) {
+				return ret, fmt.Errorf("cannot scan %v into %v: value out of range", src, t)
+			}
+			ret.SetUint(uint64(sv.Int()))
+			return ret, nil
+		}
+
+		if sv.Kind() == reflect.Float64 {
+			f := sv.Float()
+			if f != math.Trunc(f) || f < 0 || f >= math.MaxUint64 || ret.OverflowUint(uint64(f)) {
+				return ret, fmt.Errorf("cannot scan %v into %v: not an integer in range", src, t)
+			}
+			ret.SetUint(uint64(f))
+			return ret, nil
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if s, ok := scannedString(src); ok {
+			f, err := strconv.ParseFloat(strings.TrimSpace(s), t.Bits())
+			if err != nil {
+				return ret, fmt.Errorf("cannot scan %T into %v: %w", src, t, err)
+			}
+			ret.SetFloat(f)
+			return ret, nil
+		}
+
+		switch sv.Kind() {
+		case reflect.Int64:
+			ret.SetFloat(float64(sv.Int()))
+			return ret, nil
+		case reflect.Float64:
+			ret.SetFloat(sv.Float())
+			return ret, nil
+		}
+
+	case reflect.Slice:
+		if s, ok := src.(string); ok && t.Elem().Kind() == reflect.Uint8 {
+			return reflect.ValueOf([]byte(s)).Convert(t), nil
+		}
+	}
+
+	if sv.Type().ConvertibleTo(t) {
+		return sv.Convert(t), nil
+	}
+
+	return ret, fmt.Errorf("cannot scan %T into %v", src, t)
+}
+
+// scannedString returns the string in src if src is []byte or string.
+func scannedString(src interface{}) (string, bool) {
+	switch v := src.(type) {
+	case []byte:
+		return string(v), true
+	case string:
+		return v, true
+	}
+	return "", false
+}
+
+// ----------------------------------------------------------------------------
+
+// ScanRows scans all rows and closes rows.
+// Rows are scanned into Tuples of column values if t is omitted, and NULL is nil.
+// Otherwise rows are scanned into struct type t, columns are matched to fields
+// by tag db, eg: `db:"name"`, or by field names case-insensitively.
+// Fields with type Option are scanned like NullOption, and unmatched columns are ignored.
+// returns Success of Slice with element Tuple or t, or Failure with the first error.
+func ScanRows(rows *sql.Rows, t ...reflect.Type) Try {
+	defer rows.Close()
+
+	cols, err := rows.Columns()
+	if err != nil {
+		return newTraitTry(false, err)
+	}
+
+	var scan func() (reflect.Value, error)
+	var ret reflect.Value
+
+	if len(t) > 0 && t[0] != nil {
+		if t[0].Kind() != reflect.Struct {
+			return newTraitTry(false, fmt.Errorf("%v is not a struct", t[0]))
+		}
+		ret = reflect.MakeSlice(reflect.SliceOf(t[0]), 0, 0)
+		scan = structScanner(rows, cols, t[0])
+	} else {
+		ret = reflect.MakeSlice(reflect.SliceOf(typeTuple), 0, 0)
+		scan = tupleScanner(rows, len(cols))
+	}
+
+	for rows.Next() {
+		v, err := scan()
+		if err != nil {
+			return newTraitTry(false, err)
+		}
+		ret = reflect.Append(ret, v)
+	}
+
+	if err := rows.Err(); err != nil {
+		return newTraitTry(false, err)
+	}
+
+	return newTraitTry(true, seqFromValue(ret))
+}
+
+// tupleScanner returns a function scanning a row into a Tuple of n column values.
+func tupleScanner(rows *sql.Rows, n int) func() (reflect.Value, error) {
+	return func() (reflect.Value, error) {
+		values := make([]interface{}, n)
+		dest := make([]interface{}, n)
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(TupleOf(values)), nil
+	}
+}
+
+// structScanner returns a function scanning a row into struct type t.
+func structScanner(rows *sql.Rows, cols []string, t reflect.Type) func() (reflect.Value, error) {
+	fields := make([]int, len(cols))
+	for i, col := range cols {
+		fields[i] = fieldIndexOf(t, col)
+	}
+
+	return func() (reflect.Value, error) {
+		ret := reflect.New(t).Elem()
+		dest := make([]interface{}, len(cols))
+		options := make(map[int]*NullOption)
+
+		for i, idx := range fields {
+			switch {
+			case idx < 0:
+				dest[i] = new(interface{})
+			case t.Field(idx).Type == typeOption:
+				options[idx] = NullOptionOf(nil)
+				dest[i] = options[idx]
+			default:
+				dest[i] = ret.Field(idx).Addr().Interface()
+			}
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return reflect.Value{}, err
+		}
+
+		for idx, n := range options {
+			ret.Field(idx).Set(reflect.ValueOf(n.Option))
+		}
+
+		return ret, nil
+	}
+}
+
+// fieldIndexOf returns index of exported field in struct type t matching column col, or -1 if not found.
+func fieldIndexOf(t reflect.Type, col string) int {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+
+		if tag, ok := f.Tag.Lookup("db"); ok {
+			if tag == col {
+				return i
+			}
+			continue
+		}
+
+		if strings.EqualFold(f.Name, col) {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,315 @@
+package monadgo
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// fakeDriver is an in-memory database/sql driver.
+// Queries return rows of tables by query string, and Exec records arguments.
+type fakeDriver struct {
+	mux    sync.Mutex
+	tables map[string]fakeTable
+	execs  [][]driver.Value
+}
+
+type fakeTable struct {
+	cols []string
+	rows [][]driver.Value
+}
+
+type fakeConn struct {
+	d *fakeDriver
+}
+
+type fakeStmt struct {
+	d     *fakeDriver
+	query string
+}
+
+type fakeRows struct {
+	table fakeTable
+	pos   int
+}
+
+var fake = &fakeDriver{
+	tables: map[string]fakeTable{
+		"users": {
+			cols: []string{"id", "name", "email", "score"},
+			rows: [][]driver.Value{
+				{int64(1), []byte("a"), []byte("a@example.com"), float64(1.5)},
+				{int64(2), []byte("b"), nil, nil},
+			},
+		},
+		"bad": {
+			cols: []string{"id"},
+			rows: [][]driver.Value{
+				{"x"},
+			},
+		},
+	},
+}
+
+func init() {
+	sql.Register("monadgo-fake", fake)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{d}, nil
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c.d, query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.mux.Lock()
+	defer s.d.mux.Unlock()
+
+	s.d.execs = append(s.d.execs, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	table, ok := s.d.tables[s.query]
+	if !ok {
+		return nil, fmt.Errorf("no table %s", s.query)
+	}
+	return &fakeRows{table: table}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.table.cols
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.pos >= len(r.table.rows) {
+		return io.EOF
+	}
+
+	copy(dest, r.table.rows[r.pos])
+	r.pos++
+	return nil
+}
+
+func openFake(t *testing.T) *sql.DB {
+	db, err := sql.Open("monadgo-fake", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// ----------------------------------------------------------------------------
+
+func TestNullOption_Scan(t *testing.T) {
+	db := openFake(t)
+	defer db.Close()
+
+	rows, err := db.Query("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+
+	var got []string
+	for rows.Next() {
+		id := NullOptionOf(reflect.TypeOf(0))
+		name := NullOptionOf(reflect.TypeOf(""))
+		email := NullOptionOf(reflect.TypeOf(""))
+		score := NullOptionOf(nil)
+		if err := rows.Scan(id, name, email, score); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, fmt.Sprint(id, name, email, score))
+	}
+
+	expected := []string{
+		"Some(1) Some(a) Some(a@example.com) Some(1.5)",
+		"Some(2) Some(b) None None",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expect %v, got %v", expected, got)
+	}
+}
+
+func TestNullOption_Value(t *testing.T) {
+	db := openFake(t)
+	defer db.Close()
+
+	type score int
+	_, err := db.Exec("insert", NullOption{Option: SomeOf("a")}, NullOption{Option: None}, NullOption{}, NullOption{Option: SomeOf(score(3))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fake.mux.Lock()
+	defer fake.mux.Unlock()
+
+	got := fake.execs[len(fake.execs)-1]
+	expected := []driver.Value{"a", nil, nil, int64(3)}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expect %v, got %v", expected, got)
+	}
+}
+
+func TestConvertScanned(t *testing.T) {
+	tests := []struct {
+		src      interface{}
+		t        reflect.Type
+		expected interface{}
+	}{
+		{[]byte("12"), reflect.TypeOf(int8(0)), int8(12)},
+		{"7", reflect.TypeOf(uint(0)), uint(7)},
+		{int64(1), reflect.TypeOf(false), true},
+		{[]byte("2.5"), reflect.TypeOf(float32(0)), float32(2.5)},
+		{int64(3), reflect.TypeOf(0.0), 3.0},
+		{int64(3), reflect.TypeOf(""), "3"},
+		{"a", reflect.TypeOf([]byte{}), []byte("a")},
+		{2.0, reflect.TypeOf(0), 2},
+		{float64(255), reflect.TypeOf(uint8(0)), uint8(255)},
+	}
+
+	for _, test := range tests {
+		v, err := convertScanned(test.src, test.t)
+		if err != nil {
+			t.Errorf("convert %v to %v: %v", test.src, test.t, err)
+			continue
+		}
+		if !reflect.DeepEqual(v.Interface(), test.expected) {
+			t.Errorf("expect %v, got %v", test.expected, v.Interface())
+		}
+	}
+
+	if _, err := convertScanned(int64(300), reflect.TypeOf(int8(0))); err == nil {
+		t.Errorf("overflow should fail")
+	}
+
+	floats := []struct {
+		src float64
+		t   reflect.Type
+	}{
+		{1.9, reflect.TypeOf(0)},
+		{-3, reflect.TypeOf(uint8(0))},
+		{1e20, reflect.TypeOf(int8(0))},
+		{1e20, reflect.TypeOf(int64(0))},
+		{256, reflect.TypeOf(uint8(0))},
+		{math.NaN(), reflect.TypeOf(0)},
+	}
+	for _, test := range floats {
+		if v, err := convertScanned(test.src, test.t); err == nil {
+			t.Errorf("convert %v to %v should fail, got %v", test.src, test.t, v)
+		}
+	}
+
+	if _, err := convertScanned("x", reflect.TypeOf(0)); err == nil {
+		t.Errorf("x to int should fail")
+	}
+}
+
+func TestScanRows(t *testing.T) {
+	db := openFake(t)
+	defer db.Close()
+
+	rows, err := db.Query("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := ScanRows(rows)
+	if result.Failed() {
+		t.Fatal(result)
+	}
+
+	expected := "[(1,[97],[97 64 101 120 97 109 112 108 101 46 99 111 109],1.5) (2,[98],<nil>,<nil>)]"
+	if s := fmt.Sprint(result.Get()); s != expected {
+		t.Errorf("expect %s, got %s", expected, s)
+	}
+}
+
+func TestScanRows_Struct(t *testing.T) {
+	type user struct {
+		ID    int
+		Name  string `db:"name"`
+		Mail  Option `db:"email"`
+		Score NullOption
+	}
+
+	db := openFake(t)
+	defer db.Close()
+
+	rows, err := db.Query("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := ScanRows(rows, reflect.TypeOf(user{}))
+	if result.Failed() {
+		t.Fatal(result)
+	}
+
+	users := result.Get().(Slice).Get().([]user)
+	if len(users) != 2 {
+		t.Fatalf("expect 2 users, got %d", len(users))
+	}
+
+	if users[0].ID != 1 || users[0].Name != "a" || !users[0].Mail.Equal(SomeOf([]byte("a@example.com"))) || !users[0].Score.Equal(SomeOf(1.5)) {
+		t.Errorf("unexpected %+v", users[0])
+	}
+
+	if users[1].ID != 2 || users[1].Name != "b" || users[1].Mail != None || users[1].Score.Option != None {
+		t.Errorf("unexpected %+v", users[1])
+	}
+}
+
+func TestScanRows_Failure(t *testing.T) {
+	db := openFake(t)
+	defer db.Close()
+
+	rows, err := db.Query("bad")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type row struct {
+		ID int
+	}
+	if result := ScanRows(rows, reflect.TypeOf(row{})); result.OK() {
+		t.Errorf("scanning x into int should fail")
+	}
+
+	rows, err = db.Query("bad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result := ScanRows(rows, reflect.TypeOf(0)); result.OK() {
+		t.Errorf("scanning into int should fail")
+	}
+}
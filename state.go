@@ -0,0 +1,166 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// State represents cats-like State[S, A], a state transition S => (S, A).
+type State interface {
+	fmt.Stringer
+
+	// Run runs this with initial state s.
+	// returns Tuple2(final state, value).
+	Run(s interface{}) Tuple2
+
+	// RunS runs this with initial state s, and returns the final state.
+	RunS(s interface{}) interface{}
+
+	// RunA runs this with initial state s, and returns the value.
+	RunA(s interface{}) interface{}
+
+	// Map applies f to the value.
+	// f: func(A) B
+	// returns State[S, B]
+	Map(f interface{}) State
+
+	// FlatMap binds the function f across the value,
+	// and runs the new State with the state from this.
+	// f: func(A) State
+	FlatMap(f interface{}) State
+
+	// run runs this with reflect.Value of state.
+	run(s reflect.Value) (reflect.Value, reflect.Value)
+}
+
+type state struct {
+	f func(reflect.Value) (reflect.Value, reflect.Value)
+}
+
+var _ State = &state{}
+
+func (st *state) String() string {
+	return "State"
+}
+
+func (st *state) run(s reflect.Value) (reflect.Value, reflect.Value) {
+	return st.f(s)
+}
+
+func (st *state) Run(s interface{}) Tuple2 {
+	s2, a := st.run(valueOf(s))
+	return newTuple2(s2.Type(), a.Type(), s2, a)
+}
+
+func (st *state) RunS(s interface{}) interface{} {
+	s2, _ := st.run(valueOf(s))
+	return s2.Interface()
+}
+
+func (st *state) RunA(s interface{}) interface{} {
+	_, a := st.run(valueOf(s))
+	return a.Interface()
+}
+
+func (st *state) Map(f interface{}) State {
+	fw := funcOf(f)
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			s2, a := st.run(s)
+			return s2, fw.call(a)
+		},
+	}
+}
+
+func (st *state) FlatMap(f interface{}) State {
+	fw := funcOf(f)
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			s2, a := st.run(s)
+			return fw.call(a).Interface().(State).run(s2)
+		},
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// StateOf returns a State.
+// f: func(S) (S, A)
+func StateOf(f interface{}) State {
+	fw := funcOf(f)
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			p := fw.call(s).Interface().(Pair)
+			return p.vals[0], p.vals[1]
+		},
+	}
+}
+
+// PureState returns a State with value x and unchanged state.
+func PureState(x interface{}) State {
+	xval := valueOf(x)
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			return s, xval
+		},
+	}
+}
+
+// GetState returns a State with the current state as value.
+func GetState() State {
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			return s, s
+		},
+	}
+}
+
+// SetState returns a State replacing state with s and Unit value.
+func SetState(s interface{}) State {
+	sval := valueOf(s)
+	return &state{
+		f: func(reflect.Value) (reflect.Value, reflect.Value) {
+			return sval, unitValue
+		},
+	}
+}
+
+// ModifyState returns a State modifying state with f and Unit value.
+// f: func(S) S
+func ModifyState(f interface{}) State {
+	fw := funcOf(f)
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			return fw.call(s), unitValue
+		},
+	}
+}
+
+// InspectState returns a State with value from applying f to state.
+// f: func(S) A
+func InspectState(f interface{}) State {
+	fw := funcOf(f)
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			return s, fw.call(s)
+		},
+	}
+}
+
+// SequenceState turns a Slice of State inside out.
+// x: Go slice or Slice of State.
+// returns State of Slice with all values, threading state through all States in order.
+func SequenceState(x interface{}) State {
+	seq := seqOf(x)
+
+	return &state{
+		f: func(s reflect.Value) (reflect.Value, reflect.Value) {
+			var ret, a reflect.Value
+			for i := 0; i < seq.len; i++ {
+				s, a = seq.v.Index(i).Interface().(State).run(s)
+				ret = widenAppendSlice(ret, a)
+			}
+			return s, reflect.ValueOf(SliceOf(ret))
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package monadgo
+
+import (
+	"fmt"
+)
+
+func ExampleStateOf() {
+	pop := StateOf(func(s []int) ([]int, int) {
+		return s[1:], s[0]
+	})
+
+	push := func(x int) State {
+		return ModifyState(func(s []int) []int {
+			return append([]int{x}, s...)
+		})
+	}
+
+	st := pop.FlatMap(func(x int) State {
+		return pop.Map(func(y int) int {
+			return x + y
+		})
+	}).FlatMap(push)
+
+	fmt.Println(st.Run([]int{1, 2, 3}))
+	printGet(st.RunS([]int{1, 2, 3}))
+
+	// Output:
+	// ([3 3],Void)
+	// [3 3], []int
+}
+
+func ExampleSequenceState() {
+	next := StateOf(func(s int) (int, string) {
+		return s + 1, fmt.Sprintf("#%d", s)
+	})
+
+	st := SequenceState([]State{next, next, GetState(), SetState(100), InspectState(func(s int) int {
+		return s * 2
+	})})
+
+	t := st.Run(1)
+	printGet(t.V1())
+	printGet(t.V2().(Slice).Get())
+	printGet(PureState("a").RunA(1))
+
+	// Output:
+	// 100, int
+	// [#1 #2 3 Void 200], []interface {}
+	// a, string
+}
@@ -0,0 +1,273 @@
+package monadgo
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var typeContext = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// Task represents a lazy computation like IO in cats-effect.
+// Task describes work without running it, and it can run more than once.
+// Panics in functions invoked by Task are converted to Failure.
+type Task interface {
+	fmt.Stringer
+
+	// Map applies f to the successful result.
+	// f: func(T) X, X can be (Y, error) or (Y, bool).
+	// returns Task[X]
+	Map(f interface{}) Task
+
+	// FlatMap binds the function f across the successful result.
+	// f: func(T) Task
+	FlatMap(f interface{}) Task
+
+	// Recover applies f to the failure.
+	// f: func(error or bool) X
+	Recover(f interface{}) Task
+
+	// RecoverWith binds the function f across the failure.
+	// f: func(error or bool) Task
+	RecoverWith(f interface{}) Task
+
+	// Attempt returns a Task never failing, with the result of this as a Try value.
+	Attempt() Task
+
+	// Timeout returns a Task failing with ErrTimeout if this does not complete within d.
+	Timeout(d time.Duration) Task
+
+	// Retry returns a Task running this at most n more times until it is successful.
+	Retry(n int) Task
+
+	// RunSync runs this and waits for the result.
+	// Functions with context.Context as first argument receive ctx.
+	// returns Failure of ErrCanceled if ctx is canceled before this runs.
+	RunSync(ctx context.Context) Try
+
+	// RunAsync runs this in a Future.
+	// Canceling the Future cancels the context passed to functions of this.
+	RunAsync(ctx context.Context) Future
+
+	// run runs this with ctx.
+	run(ctx context.Context) Try
+}
+
+type task struct {
+	f func(context.Context) Try
+}
+
+var _ Task = &task{}
+
+func (t *task) String() string {
+	return "Task"
+}
+
+// contextFailure returns Failure of err from a done context.
+// context.Canceled is ErrCanceled like canceled Futures.
+func contextFailure(err error) Try {
+	if err == context.Canceled {
+		return cancelFailure
+	}
+	return FailureOf(err)
+}
+
+// run runs f of t with ctx, and returns Failure if ctx is done or f panics.
+func (t *task) run(ctx context.Context) (ret Try) {
+	if err := ctx.Err(); err != nil {
+		return contextFailure(err)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			ret = panicFailure(r)
+		}
+	}()
+
+	return t.f(ctx)
+}
+
+func (t *task) Map(f interface{}) Task {
+	fw := funcOf(f)
+	return &task{
+		f: func(ctx context.Context) Try {
+			return t.run(ctx).FlatMap(func(x interface{}) Try {
+				return tryCBF(fw.call(valueOf(x)))
+			})
+		},
+	}
+}
+
+func (t *task) FlatMap(f interface{}) Task {
+	fw := funcOf(f)
+	return &task{
+		f: func(ctx context.Context) Try {
+			v := t.run(ctx)
+			if v.Failed() {
+				return v
+			}
+			return fw.call(v.rv()).Interface().(Task).run(ctx)
+		},
+	}
+}
+
+func (t *task) Recover(f interface{}) Task {
+	fw := funcOf(f)
+	return &task{
+		f: func(ctx context.Context) Try {
+			v := t.run(ctx)
+			if v.OK() {
+				return v
+			}
+			return tryCBF(fw.call(v.rv()))
+		},
+	}
+}
+
+func (t *task) RecoverWith(f interface{}) Task {
+	fw := funcOf(f)
+	return &task{
+		f: func(ctx context.Context) Try {
+			v := t.run(ctx)
+			if v.OK() {
+				return v
+			}
+			return fw.call(v.rv()).Interface().(Task).run(ctx)
+		},
+	}
+}
+
+func (t *task) Attempt() Task {
+	return &task{
+		f: func(ctx context.Context) Try {
+			return newTraitTry(true, t.run(ctx))
+		},
+	}
+}
+
+func (t *task) Timeout(d time.Duration) Task {
+	return &task{
+		f: func(ctx context.Context) Try {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+
+			result := make(chan Try, 1)
+			go func() {
+				result <- t.run(ctx)
+			}()
+
+			var v Try
+			select {
+			case v = <-result:
+			case <-ctx.Done():
+				v = contextFailure(ctx.Err())
+			}
+
+			if v.Failed() && v.Get() == context.DeadlineExceeded && ctx.Err() == context.DeadlineExceeded {
+				return FailureOf(ErrTimeout)
+			}
+			return v
+		},
+	}
+}
+
+func (t *task) Retry(n int) Task {
+	return &task{
+		f: func(ctx context.Context) Try {
+			v := t.run(ctx)
+			for i := 0; i < n && v.Failed() && ctx.Err() == nil; i++ {
+				v = t.run(ctx)
+			}
+			return v
+		},
+	}
+}
+
+func (t *task) RunSync(ctx context.Context) Try {
+	return t.run(ctx)
+}
+
+func (t *task) RunAsync(ctx context.Context) Future {
+	p := DefaultPromise(ctx)
+
+	go func() {
+		p.Complete(t.run(p.ctx))
+	}()
+
+	return p
+}
+
+// ----------------------------------------------------------------------------
+
+// TaskOf returns a Task running f.
+// f: func() X, or func(context.Context) X.
+// X can be (Y, error) or (Y, bool), and returns Failure if last result is error or false.
+func TaskOf(f interface{}) Task {
+	fw := funcOf(f)
+	ftyp := reflect.TypeOf(f)
+	withContext := ftyp.NumIn() == 1 && ftyp.In(0) == typeContext
+
+	return &task{
+		f: func(ctx context.Context) Try {
+			if withContext {
+				return tryCBF(fw.call(reflect.ValueOf(&ctx).Elem()))
+			}
+			return tryCBF(fw.call(unitValue))
+		},
+	}
+}
+
+// TaskFromTry returns a Task with result t.
+func TaskFromTry(t Try) Task {
+	return &task{
+		f: func(context.Context) Try {
+			return t
+		},
+	}
+}
+
+// PureTask returns a Task with successful result x.
+func PureTask(x interface{}) Task {
+	return TaskFromTry(SuccessOf(x))
+}
+
+// Bracket returns a Task acquiring a resource, using it, and releasing it.
+// release is always invoked if acquire is successful,
+// even if use fails, panics, or is canceled.
+// acquire: Task of resource R.
+// use: func(R) Task
+// release: func(R) or func(R) error
+// returns Failure of error from release if use is successful and release fails,
+// or Failure of SuppressedError if both use and release fail, like Using.
+func Bracket(acquire Task, use, release interface{}) Task {
+	uw := funcOf(use)
+	rw := funcOf(release)
+
+	return &task{
+		f: func(ctx context.Context) (ret Try) {
+			r := acquire.run(ctx)
+			if r.Failed() {
+				return r
+			}
+
+			defer func() {
+				released := (&task{
+					f: func(context.Context) Try {
+						return tryCBF(rw.call(r.rv()))
+					},
+				}).run(context.Background())
+
+				if released.Failed() {
+					ret = suppress(ret, Errors{errorOf(released.Get())})
+				}
+			}()
+
+			return (&task{
+				f: func(ctx context.Context) Try {
+					return uw.call(r.rv()).Interface().(Task).run(ctx)
+				},
+			}).run(ctx)
+		},
+	}
+}
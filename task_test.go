@@ -0,0 +1,221 @@
+package monadgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func ExampleTaskOf() {
+	count := 0
+	t := TaskOf(func() int {
+		count++
+		return count
+	}).Map(func(x int) int {
+		return x * 10
+	})
+
+	fmt.Println(count)
+	fmt.Println(t.RunSync(context.Background()))
+	fmt.Println(t.RunSync(context.Background()))
+
+	failed := TaskOf(func() (int, error) {
+		return 0, errors.New("oops")
+	})
+	fmt.Println(failed.RunSync(context.Background()))
+
+	// Output:
+	// 0
+	// Success(10)
+	// Success(20)
+	// Failure(oops)
+}
+
+func ExampleTask_FlatMap() {
+	t := PureTask(1).FlatMap(func(x int) Task {
+		return TaskOf(func() int {
+			return x + 1
+		})
+	})
+
+	fmt.Println(t.RunSync(context.Background()))
+
+	// Output:
+	// Success(2)
+}
+
+func ExampleTask_Recover() {
+	t := TaskOf(func() int {
+		panic("boom")
+	}).Recover(func(err error) int {
+		fmt.Println(err)
+		return -1
+	})
+
+	fmt.Println(t.RunSync(context.Background()))
+
+	// Output:
+	// boom
+	// Success(-1)
+}
+
+func ExampleTask_Attempt() {
+	t := TaskFromTry(FailureOf(errors.New("oops"))).Attempt()
+
+	fmt.Println(t.RunSync(context.Background()))
+
+	// Output:
+	// Success(Failure(oops))
+}
+
+func ExampleTask_Retry() {
+	count := 0
+	t := TaskOf(func() (int, error) {
+		count++
+		if count < 3 {
+			return 0, fmt.Errorf("failed %d", count)
+		}
+		return count, nil
+	})
+
+	fmt.Println(t.Retry(1).RunSync(context.Background()))
+	fmt.Println(t.Retry(1).RunSync(context.Background()))
+
+	// Output:
+	// Failure(failed 2)
+	// Success(3)
+}
+
+func ExampleBracket() {
+	t := Bracket(PureTask("file"), func(r string) Task {
+		return TaskOf(func() string {
+			fmt.Println("use", r)
+			panic("boom")
+		})
+	}, func(r string) {
+		fmt.Println("release", r)
+	})
+
+	fmt.Println(t.RunSync(context.Background()))
+
+	// Output:
+	// use file
+	// release file
+	// Failure(boom)
+}
+
+func TestTask_Timeout(t *testing.T) {
+	task := TaskOf(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}).Timeout(100 * time.Millisecond)
+
+	v := task.RunSync(context.Background())
+	if v.OK() || v.Get() != ErrTimeout || !errors.Is(ErrTimeout, context.DeadlineExceeded) {
+		t.Errorf("expect Failure(%v), but %v", ErrTimeout, v)
+	}
+
+	v = PureTask(1).Timeout(time.Second).RunSync(context.Background())
+	if !v.OK() || v.Get() != 1 {
+		t.Errorf("expect Success(1), but %v", v)
+	}
+}
+
+func TestTask_RunAsync(t *testing.T) {
+	f := TaskOf(func() int {
+		return 1
+	}).RunAsync(context.Background())
+
+	f.Ready(wait)
+	if v := f.Value().Get(); v != 1 {
+		t.Errorf("expect Success(1), but %v", v)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	v := PureTask(1).RunSync(ctx)
+	if v.OK() || v.Get() != ErrCanceled || !errors.Is(v.Get().(error), context.Canceled) {
+		t.Errorf("expect Failure(%v), but %v", ErrCanceled, v)
+	}
+
+	v = TaskOf(func(ctx context.Context) error {
+		<-ctx.Done()
+		return nil
+	}).Timeout(time.Second).RunSync(ctx)
+	if v.OK() || v.Get() != ErrCanceled {
+		t.Errorf("expect Failure(%v), but %v", ErrCanceled, v)
+	}
+}
+
+func TestBracket_Cancel(t *testing.T) {
+	var released int32
+	done := make(chan struct{})
+
+	task := Bracket(PureTask(1), func(r int) Task {
+		return TaskOf(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+	}, func(r int) error {
+		atomic.AddInt32(&released, 1)
+		close(done)
+		return nil
+	})
+
+	f := task.RunAsync(context.Background())
+	time.Sleep(50 * time.Millisecond)
+	f.Cancel()
+
+	select {
+	case <-done:
+	case <-time.After(wait):
+		t.Fatal("resource is not released")
+	}
+
+	if n := atomic.LoadInt32(&released); n != 1 {
+		t.Errorf("expect released once, but %d", n)
+	}
+
+	errRelease := errors.New("release")
+	v := Bracket(PureTask(1), func(r int) Task {
+		return PureTask(r)
+	}, func(r int) error {
+		return errRelease
+	}).RunSync(context.Background())
+
+	if v.OK() || v.Get() != errRelease {
+		t.Errorf("expect Failure(%v), but %v", errRelease, v)
+	}
+}
+
+func TestBracket_Suppressed(t *testing.T) {
+	errUse := errors.New("use")
+	errRelease := errors.New("release")
+
+	v := Bracket(PureTask(1), func(r int) Task {
+		return TaskOf(func() (int, error) {
+			return 0, errUse
+		})
+	}, func(r int) error {
+		return errRelease
+	}).RunSync(context.Background())
+
+	var serr *SuppressedError
+	if v.OK() || !errors.As(v.Get().(error), &serr) || serr.Err != errUse || !errors.Is(serr, errRelease) {
+		t.Errorf("expect Failure of %v suppressing %v, but %v", errUse, errRelease, v)
+	}
+
+	v = Bracket(PureTask(1), func(r int) Task {
+		panic("use")
+	}, func(r int) error {
+		return errRelease
+	}).RunSync(context.Background())
+
+	var perr *PanicError
+	if v.OK() || !errors.As(v.Get().(error), &perr) || !errors.Is(v.Get().(error), errRelease) {
+		t.Errorf("expect Failure of PanicError suppressing %v, but %v", errRelease, v)
+	}
+}
@@ -32,15 +32,55 @@ type Traversable interface {
 	Fold(z, f interface{}) interface{}
 
 	// Reduce reduces the elements of this using the specified associative binary operator.
+	// It panics if this is empty, use ReduceOption instead.
 	// f: func(T, T)
 	// returns value with type T.
 	Reduce(f interface{}) interface{}
 
+	// ReduceOption reduces the elements of this using the specified associative binary operator,
+	// or returns None if this is empty.
+	// f: func(T, T) T
+	// returns Option of value with type T.
+	ReduceOption(f interface{}) Option
+
+	// ReduceLeftOption reduces the elements of this going left to right using the specified binary operator,
+	// or returns None if this is empty.
+	// f: func(T, T) T
+	// returns Option of value with type T.
+	ReduceLeftOption(f interface{}) Option
+
 	// GroupBy returns Map with K -> Go slice. Key is the result of f. Collect elements into a slice with same resulting key value.
+	// Returns an empty Map if this is empty.
 	// f: func(T) K
 	// returns Map(K -> Go slice)
 	GroupBy(f interface{}) Map
 
+	// GroupMap returns Map with K -> Go slice. Key is the result of key, and value is the result of value.
+	// Collect values into a slice with same resulting key value.
+	// key: func(T) K
+	// value: func(T) V
+	// returns Map(K -> Go slice of V)
+	GroupMap(key, value interface{}) Map
+
+	// GroupMapReduce returns Map with K -> V. Key is the result of key, and value is the result of value.
+	// Values with same resulting key value are reduced by reduce.
+	// key: func(T) K
+	// value: func(T) V
+	// reduce: func(V, V) V
+	// returns Map(K -> V)
+	GroupMapReduce(key, value, reduce interface{}) Map
+
+	// CountBy returns Map with K -> int. Key is the result of f, and value is the number of elements with same resulting key value.
+	// f: func(T) K
+	// returns Map(K -> int)
+	CountBy(f interface{}) Map
+
+	// PartitionMap applies f to all elements and splits results into a pair of Lefts and Rights.
+	// Go slices are typed by f if f is func(T) (X, error), otherwise by values on each side.
+	// f: func(T) Either, func(T) (X, error) or func(T) (X, bool)
+	// returns Tuple2(Go slice of Left values, Go slice of Right values)
+	PartitionMap(f interface{}) Tuple2
+
 	// Exists tests whether a predicate holds for at least one element of this sequence.
 	// f: func(T) bool
 	Exists(f interface{}) bool
@@ -60,8 +100,47 @@ type Traversable interface {
 	// f: func(T) bool
 	Split(f interface{}) Tuple2
 
+	// MapE applies function f to all elements.
+	// Results of f are converted to Try like TryOf.
+	// f: func(T) (X, error), func(T) (X, bool) or func(T) Try
+	// returns Success of Traversable with element type X,
+	// or the first Failure, or Failure of Errors if mode is CollectErrors.
+	// Element type is the type of Success values if X is an interface, like Try.
+	MapE(f interface{}, mode ...ErrorMode) Try
+
+	// FlatMapE applies f to all elements and builds a new Travesable from result.
+	// Results of f are converted to Try like TryOf.
+	// f: func(T) (X, error), func(T) (X, bool) or func(T) Try, X can be Go slice, or map.
+	// returns Success of Traversable,
+	// or the first Failure, or Failure of Errors if mode is CollectErrors.
+	// Element type is from types of Success values if X is an interface, like Try.
+	FlatMapE(f interface{}, mode ...ErrorMode) Try
+
+	// FoldE folds the elements using specified binary operator.
+	// Results of f are converted to Try like TryOf.
+	// Failed steps are skipped if mode is CollectErrors.
+	// z: func() Z or value of type Z.
+	// f: func(Z, T) (Z, error) or func(Z, T) (Z, bool)
+	// returns Success of value with type Z,
+	// or the first Failure, or Failure of Errors if mode is CollectErrors.
+	// returns Failure if z is nil, because type Z is unknown.
+	FoldE(z, f interface{}, mode ...ErrorMode) Try
+
+	// ForeachE applies f to all element.
+	// Results of f are converted to Try like TryOf.
+	// f: func(T) error or func(T) bool
+	// returns Success of Unit,
+	// or the first Failure, or Failure of Errors if mode is CollectErrors.
+	ForeachE(f interface{}, mode ...ErrorMode) Try
+
 	// Collect returns elements satisfying pf.
 	// pf is a partial function consisting of Condition func(T) bool and Action func(T) X.
 	// returns a new Traversable[X]
 	Collect(pf PartialFunc) Traversable
+
+	// Equal reports whether this and other have deeply equal elements, like Equal.
+	Equal(other Any) bool
+
+	// Hash returns the hash of elements, consistent with Equal.
+	Hash() uint64
 }
@@ -19,6 +19,7 @@ type Try interface {
 	Failed() bool
 
 	// Foreach applies f to Try's value if this is Success.
+	// Panics of f are not recovered, because Foreach has no result to hold them.
 	// f: func(T)
 	Foreach(f interface{})
 
@@ -26,6 +27,7 @@ type Try interface {
 	// or f if this is a Success.
 	// If f is initially applied and last element in results is false or error,
 	// then z applied with this element value.
+	// If f panics, z is applied with PanicError. Panics of z are not recovered.
 	// z: func(A) X. A can be error or bool.
 	// f: func(B) X. B is the element type in Success.
 	// returns value with type X.
@@ -41,8 +43,46 @@ type Try interface {
 	// f: func(T) Try
 	FlatMap(f interface{}) Try
 
+	// Flatten returns the nested Try if this is Success of Try,
+	// otherwise return this.
+	Flatten() Try
+
+	// Filter returns this if this is a Failure or f returns true for the value,
+	// otherwise return Failure of a no such element error.
+	// f: func(T) bool
+	Filter(f interface{}) Try
+
+	// Recover applies f to the value from this Failure,
+	// or returns this if this is a Success.
+	// f: func(error or bool) X
+	Recover(f interface{}) Try
+
+	// RecoverWith returns the f applied to the value from this Failure,
+	// or returns this if this is a Success.
+	// f: func(error or bool) Try
+	RecoverWith(f interface{}) Try
+
+	// Transform returns s applied to the value if this is a Success,
+	// or f applied to the value if this is a Failure.
+	// s: func(T) Try
+	// f: func(error or bool) Try
+	Transform(s, f interface{}) Try
+
+	// FailedTry returns Success of the error from this Failure,
+	// or Failure of a no such element error if this is a Success.
+	FailedTry() Try
+
+	// Zip returns Success of Tuple2 with values of this and that if both are Success,
+	// otherwise return the first Failure.
+	Zip(that Try) Try
+
+	// ZipWith returns the result from applying f to values of this and that if both are Success,
+	// otherwise return the first Failure.
+	// f: func(T, U) X
+	ZipWith(that Try, f interface{}) Try
+
 	// OrElse returns this if it's a Success,
-	// or z if this is a Failure.
+	// or z if this is a Failure. If z panics, returns Failure of PanicError.
 	OrElse(z TryOrElse) Try
 
 	// GetOrElse returns the value from this Success,
@@ -52,6 +92,25 @@ type Try interface {
 	// ToOption returns None if this is a Failure,
 	// or a Some containing Success's value.
 	ToOption() Option
+
+	// ToEither returns Right of the value if this is a Success,
+	// or Left of the value if this is a Failure.
+	ToEither() Either
+
+	// ToGo returns the value and nil if this is a Success,
+	// or nil and the error if this is a Failure. Failure of false returns ErrFalse.
+	ToGo() (interface{}, error)
+
+	// Equal reports whether this and other are both Success or both Failure with deeply equal values.
+	Equal(other Any) bool
+
+	// Hash returns the hash of this, consistent with Equal.
+	Hash() uint64
+
+	// Compare compares this and other. Failure is less than Success, and values of the same kind are compared.
+	// Errors are compared by their messages.
+	// returns -1 if this < other, 0 if this == other, and +1 if this > other.
+	Compare(other Try) int
 }
 
 type traitTry struct {
@@ -99,7 +158,9 @@ func (t *traitTry) Fold(z, f interface{}) interface{} {
 		return z
 	}
 
-	result := tryCBF(funcOf(f).call(t.v))
+	result := safeTry(func() Try {
+		return tryCBF(funcOf(f).call(t.v))
+	})
 	if result.OK() {
 		return result.Get()
 	}
@@ -109,7 +170,9 @@ func (t *traitTry) Fold(z, f interface{}) interface{} {
 
 func (t *traitTry) Map(f interface{}) Try {
 	if t.ok {
-		return tryCBF(funcOf(f).call(t.v))
+		return safeTry(func() Try {
+			return tryCBF(funcOf(f).call(t.v))
+		})
 	}
 
 	return t
@@ -117,14 +180,84 @@ func (t *traitTry) Map(f interface{}) Try {
 
 func (t *traitTry) FlatMap(f interface{}) Try {
 	if t.ok {
-		return funcOf(f).call(t.v).Interface().(Try)
+		return safeTry(func() Try {
+			return funcOf(f).call(t.v).Interface().(Try)
+		})
+	}
+	return t
+}
+
+func (t *traitTry) Flatten() Try {
+	if t.ok {
+		if x, ok := t.Get().(Try); ok {
+			return x
+		}
+	}
+
+	return t
+}
+
+func (t *traitTry) Filter(f interface{}) Try {
+	if !t.ok {
+		return t
+	}
+
+	return safeTry(func() Try {
+		if funcOf(f).call(t.v).Bool() {
+			return t
+		}
+		return newTraitTry(false, fmt.Errorf("%w: predicate does not hold for %v", ErrNoSuchElement, t.Get()))
+	})
+}
+
+func (t *traitTry) Recover(f interface{}) Try {
+	if !t.ok {
+		return safeTry(func() Try {
+			return tryCBF(funcOf(f).call(t.v))
+		})
 	}
+
 	return t
 }
 
+func (t *traitTry) RecoverWith(f interface{}) Try {
+	if !t.ok {
+		return safeTry(func() Try {
+			return funcOf(f).call(t.v).Interface().(Try)
+		})
+	}
+
+	return t
+}
+
+func (t *traitTry) Transform(s, f interface{}) Try {
+	return safeTry(func() Try {
+		if t.ok {
+			return funcOf(s).call(t.v).Interface().(Try)
+		}
+		return funcOf(f).call(t.v).Interface().(Try)
+	})
+}
+
+func (t *traitTry) FailedTry() Try {
+	if !t.ok {
+		return &traitTry{ok: true, v: reflect.ValueOf(errorOf(t.Get()))}
+	}
+
+	return newTraitTry(false, fmt.Errorf("%w: Success has no failure", ErrNoSuchElement))
+}
+
+func (t *traitTry) Zip(that Try) Try {
+	return mapNTry(nil, t, that)
+}
+
+func (t *traitTry) ZipWith(that Try, f interface{}) Try {
+	return mapNTry(f, t, that)
+}
+
 func (t *traitTry) OrElse(z TryOrElse) Try {
 	if !t.ok {
-		return z()
+		return safeTry(z)
 	}
 
 	return t
@@ -138,6 +271,33 @@ func (t *traitTry) GetOrElse(z interface{}) interface{} {
 	return t.Get()
 }
 
+func (t *traitTry) ToEither() Either {
+	return &traitEither{
+		right: t.ok,
+		v:     t.v,
+	}
+}
+
+func (t *traitTry) ToGo() (interface{}, error) {
+	if t.ok {
+		return t.Get(), nil
+	}
+
+	return nil, errorOf(t.Get())
+}
+
+func (t *traitTry) Equal(other Any) bool {
+	return Equal(t, other)
+}
+
+func (t *traitTry) Hash() uint64 {
+	return Hash(t)
+}
+
+func (t *traitTry) Compare(other Try) int {
+	return Compare(t, other)
+}
+
 func (t *traitTry) ToOption() Option {
 	if !t.ok {
 		return None
@@ -216,8 +376,7 @@ func tryCBF(x ...interface{}) (ret Try) {
 			if reflect.TypeOf(v).Kind() == reflect.Func {
 				defer func() {
 					if r := recover(); r != nil {
-						ret = newTraitTry(false, fmt.Errorf("%v", r))
-
+						ret = panicFailure(r)
 					}
 				}()
 
@@ -1,6 +1,7 @@
 package monadgo
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -42,7 +43,7 @@ func ExampleTryOf() {
 	// Success(10), *monadgo.traitTry
 	// 10, int
 	// Failure(runtime error: integer divide by zero), *monadgo.traitTry
-	// runtime error: integer divide by zero, *errors.errorString
+	// runtime error: integer divide by zero, *monadgo.PanicError
 }
 
 func ExampleTry1Of() {
@@ -353,3 +354,71 @@ func ExampleTry_ToOption() {
 	// Some(10), *monadgo.traitOption
 
 }
+
+func ExampleTry_Recover() {
+	errOops := errors.New("oops")
+	failure := TryOf(0, errOops)
+
+	fmt.Println(failure.Recover(func(err error) int {
+		return -1
+	}))
+	fmt.Println(TryOf(1, nil).Recover(func(err error) int {
+		return -1
+	}))
+
+	fmt.Println(failure.RecoverWith(func(err error) Try {
+		return TryOf(0, fmt.Errorf("wrapped: %w", err))
+	}))
+
+	fmt.Println(failure.Transform(func(x int) Try {
+		return SuccessOf(x + 1)
+	}, func(err error) Try {
+		return SuccessOf(err.Error())
+	}))
+
+	// Output:
+	// Success(-1)
+	// Success(1)
+	// Failure(wrapped: oops)
+	// Success(oops)
+}
+
+func ExampleTry_Filter() {
+	positive := func(x int) bool {
+		return x > 0
+	}
+
+	fmt.Println(TryOf(1, nil).Filter(positive))
+	fmt.Println(TryOf(-1, nil).Filter(positive))
+	fmt.Println(errors.Is(TryOf(-1, nil).Filter(positive).Get().(error), ErrNoSuchElement))
+
+	// Output:
+	// Success(1)
+	// Failure(no such element: predicate does not hold for -1)
+	// true
+}
+
+func ExampleTry_FailedTry() {
+	fmt.Println(TryOf(0, errors.New("oops")).FailedTry())
+	fmt.Println(TryOf(false).FailedTry())
+	fmt.Println(TryOf(1, nil).FailedTry())
+
+	// Output:
+	// Success(oops)
+	// Success(false)
+	// Failure(no such element: Success has no failure)
+}
+
+func ExampleTry_Flatten() {
+	fmt.Println(newTraitTry(true, TryOf(1, nil)).Flatten())
+	fmt.Println(TryOf(1, nil).Flatten())
+
+	fmt.Println(TryOf(1, nil).ToEither())
+	fmt.Println(TryOf(0, errors.New("oops")).ToEither())
+
+	// Output:
+	// Success(1)
+	// Success(1)
+	// Right(1)
+	// Left(oops)
+}
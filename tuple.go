@@ -19,6 +19,17 @@ type Tuple interface {
 	// V returns the value of n-index element.
 	V(n int) interface{}
 
+	// Equal reports whether this and other have the same dimension and deeply equal elements.
+	// Pair is equal to Tuple2 with equal elements.
+	Equal(other Any) bool
+
+	// Hash returns the hash of this, consistent with Equal.
+	Hash() uint64
+
+	// Compare compares elements of this and other lexicographically.
+	// returns -1 if this < other, 0 if this == other, and +1 if this > other.
+	Compare(other Tuple) int
+
 	// toValues returns slice of reflect.Value in Tuple.
 	toValues() []reflect.Value
 
@@ -88,6 +99,18 @@ func (t TupleN) toValues() []reflect.Value {
 	return t.vals
 }
 
+func (t TupleN) Equal(other Any) bool {
+	return Equal(t, other)
+}
+
+func (t TupleN) Hash() uint64 {
+	return Hash(t)
+}
+
+func (t TupleN) Compare(other Tuple) int {
+	return Compare(t, other)
+}
+
 // ----------------------------------------------------------------------------
 
 // TupleOf returns a general Tuple.
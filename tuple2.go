@@ -36,6 +36,18 @@ func (t Tuple2) toValues() []reflect.Value {
 	return t.vals[0:]
 }
 
+func (t Tuple2) Equal(other Any) bool {
+	return Equal(t, other)
+}
+
+func (t Tuple2) Hash() uint64 {
+	return Hash(t)
+}
+
+func (t Tuple2) Compare(other Tuple) int {
+	return Compare(t, other)
+}
+
 func (t Tuple2) reduce() Tuple {
 	return t
 }
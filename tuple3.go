@@ -36,6 +36,18 @@ func (t Tuple3) toValues() []reflect.Value {
 	return t.vals[0:]
 }
 
+func (t Tuple3) Equal(other Any) bool {
+	return Equal(t, other)
+}
+
+func (t Tuple3) Hash() uint64 {
+	return Hash(t)
+}
+
+func (t Tuple3) Compare(other Tuple) int {
+	return Compare(t, other)
+}
+
 func (t Tuple3) reduce() Tuple {
 	return formTuple2(t.types[0], t.types[1], t.values[0], t.values[1])
 }
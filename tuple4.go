@@ -36,6 +36,18 @@ func (t Tuple4) toValues() []reflect.Value {
 	return t.vals[0:]
 }
 
+func (t Tuple4) Equal(other Any) bool {
+	return Equal(t, other)
+}
+
+func (t Tuple4) Hash() uint64 {
+	return Hash(t)
+}
+
+func (t Tuple4) Compare(other Tuple) int {
+	return Compare(t, other)
+}
+
 func (t Tuple4) reduce() Tuple {
 	return formTuple3(t.types[0], t.types[1], t.types[2], t.values[0], t.values[1], t.values[2])
 }
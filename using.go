@@ -0,0 +1,164 @@
+package monadgo
+
+import (
+	"io"
+	"reflect"
+)
+
+// Using acquires a resource, applies use to it, and always releases it, like Using in Scala.
+// acquire: R, or func() R, or func() (R, error).
+// use: func(R) X, X can be (Y, error) or (Y, bool).
+// release: optional func(R) or func(R) error. Close is invoked if release is omitted and R is io.Closer.
+// returns Failure of SuppressedError if both use and release fail.
+func Using(acquire, use interface{}, release ...interface{}) Try {
+	r := tryCBF(acquire)
+	if r.Failed() {
+		return r
+	}
+
+	fw := funcOf(use)
+	ret := tryCBF(func() Try {
+		return tryCBF(fw.call(r.rv()))
+	})
+
+	var errs Errors
+	if err := releaseResource(r.rv(), releaseOf(release)); err != nil {
+		errs = append(errs, err)
+	}
+
+	return suppress(ret, errs)
+}
+
+// releaseOf returns the optional release function.
+func releaseOf(release []interface{}) interface{} {
+	if len(release) > 0 {
+		return release[0]
+	}
+	return nil
+}
+
+// releaseResource releases resource r with release, or Close if release is nil and r is io.Closer.
+func releaseResource(r reflect.Value, release interface{}) error {
+	ret := tryCBF(func() Try {
+		if release != nil {
+			return tryCBF(funcOf(release).call(r))
+		}
+
+		if c, ok := r.Interface().(io.Closer); ok {
+			return tryCBF(c.Close())
+		}
+
+		return successUnit
+	})
+
+	if ret.Failed() {
+		return errorOf(ret.Get())
+	}
+	return nil
+}
+
+// suppress returns ret if there are no release errors errs.
+// If ret is Success, the first error in errs becomes primary error.
+func suppress(ret Try, errs Errors) Try {
+	if len(errs) == 0 {
+		return ret
+	}
+
+	var err error
+	if ret.OK() {
+		err, errs = errs[0], errs[1:]
+	} else {
+		err = errorOf(ret.Get())
+	}
+
+	if len(errs) == 0 {
+		return newTraitTry(false, err)
+	}
+
+	return newTraitTry(false, &SuppressedError{
+		Err:        err,
+		Suppressed: errs,
+	})
+}
+
+// ----------------------------------------------------------------------------
+
+// Manager manages multiple resources used in UsingManager, like Using.Manager in Scala.
+type Manager interface {
+	// Acquire registers a resource and returns it.
+	// x follows the convention of TryOf, eg: m.Acquire(os.Open(name)).
+	// The resource must be io.Closer.
+	// If x is Failure, UsingManager stops and returns the Failure.
+	Acquire(x ...interface{}) interface{}
+
+	// AcquireWith registers a resource released by release and returns it.
+	// release: func(R) or func(R) error
+	AcquireWith(release interface{}, x ...interface{}) interface{}
+}
+
+type resource struct {
+	v       reflect.Value
+	release interface{}
+}
+
+type manager struct {
+	resources []resource
+}
+
+// acquireFailure aborts UsingManager when Manager fails to acquire a resource.
+type acquireFailure struct {
+	t Try
+}
+
+var _ Manager = &manager{}
+
+func (m *manager) Acquire(x ...interface{}) interface{} {
+	return m.AcquireWith(nil, x...)
+}
+
+func (m *manager) AcquireWith(release interface{}, x ...interface{}) interface{} {
+	r := tryCBF(x...)
+	if r.Failed() {
+		panic(acquireFailure{r})
+	}
+
+	m.resources = append(m.resources, resource{
+		v:       r.rv(),
+		release: release,
+	})
+	return r.Get()
+}
+
+func (m *manager) run(f interface{}) (ret Try) {
+	defer func() {
+		if r := recover(); r != nil {
+			if failure, ok := r.(acquireFailure); ok {
+				ret = failure.t
+			} else {
+				ret = panicFailure(r)
+			}
+		}
+	}()
+
+	return tryCBF(funcOf(f).call(reflect.ValueOf(m)))
+}
+
+func (m *manager) release() Errors {
+	var errs Errors
+	for i := len(m.resources) - 1; i >= 0; i-- {
+		r := m.resources[i]
+		if err := releaseResource(r.v, r.release); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// UsingManager applies f to a Manager, and releases all resources acquired by Manager in reverse order.
+// f: func(Manager) X, X can be (Y, error) or (Y, bool).
+// returns Failure of SuppressedError if more than one of f and releases fail.
+func UsingManager(f interface{}) Try {
+	m := &manager{}
+	ret := m.run(f)
+	return suppress(ret, m.release())
+}
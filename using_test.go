@@ -0,0 +1,119 @@
+package monadgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type closer struct {
+	name string
+	err  error
+}
+
+func (c *closer) Close() error {
+	fmt.Println("close", c.name)
+	return c.err
+}
+
+func openCloser(name string, err error) (*closer, error) {
+	if name == "" {
+		return nil, errors.New("no name")
+	}
+	return &closer{name: name, err: err}, nil
+}
+
+func ExampleUsing() {
+	t := Using(func() (*closer, error) {
+		return openCloser("a", nil)
+	}, func(c *closer) string {
+		return "read " + c.name
+	})
+	fmt.Println(t)
+
+	t = Using(func() (*closer, error) {
+		return openCloser("", nil)
+	}, func(c *closer) string {
+		return "read " + c.name
+	})
+	fmt.Println(t)
+
+	t = Using(100, func(x int) (int, error) {
+		return 0, errors.New("use failed")
+	}, func(x int) error {
+		fmt.Println("release", x)
+		return errors.New("release failed")
+	})
+	fmt.Println(t)
+
+	// Output:
+	// close a
+	// Success(read a)
+	// Failure(no name)
+	// release 100
+	// Failure(use failed (suppressed: release failed))
+}
+
+func ExampleUsingManager() {
+	t := UsingManager(func(m Manager) string {
+		a := m.Acquire(openCloser("a", nil)).(*closer)
+		b := m.Acquire(openCloser("b", nil)).(*closer)
+		x := m.AcquireWith(func(x int) {
+			fmt.Println("release", x)
+		}, 100).(int)
+
+		return fmt.Sprintf("%s %s %d", a.name, b.name, x)
+	})
+	fmt.Println(t)
+
+	t = UsingManager(func(m Manager) string {
+		m.Acquire(openCloser("a", nil))
+		m.Acquire(openCloser("", nil))
+		panic("unreachable")
+	})
+	fmt.Println(t)
+
+	// Output:
+	// release 100
+	// close b
+	// close a
+	// Success(a b 100)
+	// close a
+	// Failure(no name)
+}
+
+func TestUsingManager_Suppressed(t *testing.T) {
+	errUse := errors.New("use")
+	errA := errors.New("a")
+	errB := errors.New("b")
+
+	ret := UsingManager(func(m Manager) error {
+		m.Acquire(openCloser("a", errA))
+		m.Acquire(openCloser("b", errB))
+		return errUse
+	})
+
+	err, ok := ret.Get().(*SuppressedError)
+	if ret.OK() || !ok {
+		t.Fatalf("expect Failure of SuppressedError, but %v", ret)
+	}
+
+	if err.Err != errUse || len(err.Suppressed) != 2 || err.Suppressed[0] != errB || err.Suppressed[1] != errA {
+		t.Errorf("expect %v suppressing [b a], but %v", errUse, err)
+	}
+
+	if !errors.Is(err, errUse) || !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("expect %v matches all errors", err)
+	}
+
+	ret = UsingManager(func(m Manager) int {
+		m.Acquire(openCloser("a", errA))
+		m.Acquire(openCloser("b", errB))
+		return 1
+	})
+
+	err, ok = ret.Get().(*SuppressedError)
+	if ret.OK() || !ok || err.Err != errB || len(err.Suppressed) != 1 || err.Suppressed[0] != errA {
+		t.Errorf("expect b suppressing [a], but %v", ret)
+	}
+}
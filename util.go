@@ -1,6 +1,7 @@
 package monadgo
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -14,6 +15,14 @@ func checkAndInvoke(x interface{}) interface{} {
 	return x
 }
 
+// valueOf returns reflect.Value of x, or nullValue if x is nil.
+func valueOf(x interface{}) reflect.Value {
+	if x == nil {
+		return nullValue
+	}
+	return reflect.ValueOf(x)
+}
+
 // ----------------------------------------------------------------------------
 
 // makeSlice returns a reflect.Value of go slice.
@@ -41,6 +50,24 @@ func appendSlice(x, y reflect.Value) reflect.Value {
 
 }
 
+// widenAppendSlice returns a reflect.Value of go slice that y appends to x.
+// x is converted to []interface{} if y is not assignable to element type of x.
+func widenAppendSlice(x, y reflect.Value) reflect.Value {
+	if !x.IsValid() {
+		return appendSlice(x, y)
+	}
+
+	if !y.Type().AssignableTo(x.Type().Elem()) {
+		z := makeSlice(typeInterface, x.Len(), x.Len()+1)
+		for i := 0; i < x.Len(); i++ {
+			z.Index(i).Set(x.Index(i))
+		}
+		x = z
+	}
+
+	return reflect.Append(x, y)
+}
+
 func oneToSlice(v reflect.Value) reflect.Value {
 	s := makeSlice(v.Type(), 1, 1)
 	s.Index(0).Set(v)
@@ -76,9 +103,14 @@ func makeMap(k, v reflect.Type, size int) reflect.Value {
 }
 
 // mergeMap returns a reflect.Value of go map that x merges y.
-func mergeMap(x, y reflect.Value) reflect.Value {
+// Keys equal to keys in idx with Equal are merged into the same key.
+func mergeMap(x, y reflect.Value, idx keyIndex) reflect.Value {
 	if !x.IsValid() {
-		return oneToMap(y)
+		x = oneToMap(y)
+		if x.Kind() == reflect.Map {
+			idx.merge(x)
+		}
+		return x
 	}
 
 	if x.Type().ConvertibleTo(typePair) {
@@ -87,11 +119,11 @@ func mergeMap(x, y reflect.Value) reflect.Value {
 
 	if y.Type().ConvertibleTo(typePair) {
 		py := y.Interface().(Pair)
-		x.SetMapIndex(reflect.ValueOf(py.Key()), reflect.ValueOf(py.Value()))
+		x.SetMapIndex(idx.add(reflect.ValueOf(py.Key())), reflect.ValueOf(py.Value()))
 	} else {
 		ity := y.MapRange()
 		for ity.Next() {
-			x.SetMapIndex(ity.Key(), ity.Value())
+			x.SetMapIndex(idx.add(ity.Key()), ity.Value())
 		}
 	}
 
@@ -119,3 +151,61 @@ func mergeKeyValue(m, k, v reflect.Value) reflect.Value {
 	m.SetMapIndex(k, v)
 	return m
 }
+
+// ----------------------------------------------------------------------------
+
+// orderedKinds groups ordered kinds which are comparable with each other.
+var orderedKinds = map[reflect.Kind]string{
+	reflect.Int: "int", reflect.Int8: "int", reflect.Int16: "int", reflect.Int32: "int", reflect.Int64: "int",
+	reflect.Uint: "uint", reflect.Uint8: "uint", reflect.Uint16: "uint", reflect.Uint32: "uint", reflect.Uint64: "uint", reflect.Uintptr: "uint",
+	reflect.Float32: "float", reflect.Float64: "float",
+	reflect.String: "string",
+}
+
+// compareValue compares x and y of ordered kinds, like int, uint, float and string.
+// returns -1 if x < y, 0 if x == y, and +1 if x > y.
+// It panics if x and y are not ordered kinds, or kinds of x and y are not comparable, like int and string.
+func compareValue(x, y reflect.Value) int {
+	if x.Kind() == reflect.Interface {
+		x = x.Elem()
+	}
+
+	if y.Kind() == reflect.Interface {
+		y = y.Elem()
+	}
+
+	if !x.IsValid() || !y.IsValid() || orderedKinds[x.Kind()] == "" || orderedKinds[x.Kind()] != orderedKinds[y.Kind()] {
+		panic(fmt.Sprintf("%v and %v are not ordered", typeOfValue(x), typeOfValue(y)))
+	}
+
+	switch orderedKinds[x.Kind()] {
+	case "int":
+		return compareOrdered(x.Int() < y.Int(), x.Int() > y.Int())
+	case "uint":
+		return compareOrdered(x.Uint() < y.Uint(), x.Uint() > y.Uint())
+	case "float":
+		return compareOrdered(x.Float() < y.Float(), x.Float() > y.Float())
+	default:
+		return compareOrdered(x.String() < y.String(), x.String() > y.String())
+	}
+}
+
+// typeOfValue returns the type of v, or nil if v is invalid.
+func typeOfValue(v reflect.Value) reflect.Type {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Type()
+}
+
+func compareOrdered(less, greater bool) int {
+	if less {
+		return -1
+	}
+
+	if greater {
+		return 1
+	}
+
+	return 0
+}
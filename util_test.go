@@ -96,22 +96,22 @@ func ExampleMergeSlice() {
 func ExampleMergeMap() {
 	x := reflect.Value{}
 	y := reflect.ValueOf(PairOf(1, 2))
-	z := mergeMap(x, y).Interface()
+	z := mergeMap(x, y, make(keyIndex)).Interface()
 	printGet(z)
 
 	x = reflect.ValueOf(PairOf(1, 2))
 	y = reflect.ValueOf(PairOf(3, 4))
-	z = mergeMap(x, y).Interface()
+	z = mergeMap(x, y, make(keyIndex)).Interface()
 	printGet(z)
 
 	x = reflect.ValueOf(map[int]int{1: 11})
 	y = reflect.ValueOf(PairOf(2, 22))
-	z = mergeMap(x, y).Interface()
+	z = mergeMap(x, y, make(keyIndex)).Interface()
 	printGet(z)
 
 	x = reflect.ValueOf(map[int]int{1: 11, 2: 22})
 	y = reflect.ValueOf(map[int]int{3: 33, 4: 44})
-	z = mergeMap(x, y).Interface()
+	z = mergeMap(x, y, make(keyIndex)).Interface()
 	printGet(z)
 
 	// Output:
@@ -0,0 +1,345 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Validated represents cats-like Validated[Errors, T].
+// Valid and Invalid are subtypes of Validated.
+// Unlike Try and Either, combining Validated values accumulates all errors.
+type Validated interface {
+	Any
+
+	// IsValid returns true if this is Valid.
+	IsValid() bool
+
+	// IsInvalid returns true if this is Invalid.
+	IsInvalid() bool
+
+	// Errors returns errors if this is Invalid, or nil if this is Valid.
+	Errors() Errors
+
+	// Map applies f to the value if this is Valid.
+	// f: func(T) X
+	// returns Validated[X]
+	Map(f interface{}) Validated
+
+	// AndThen binds the function f across Valid.
+	// Errors are not accumulated.
+	// f: func(T) Validated
+	AndThen(f interface{}) Validated
+
+	// Zip combines this and that into a Valid of Tuple2 if both are Valid,
+	// or Invalid with errors from both.
+	Zip(that Validated) Validated
+
+	// Foreach applies f to the value if this is Valid.
+	// f: func(T)
+	Foreach(f interface{})
+
+	// Fold applies z if this is Invalid, or f if this is Valid.
+	// z: func(Errors) X
+	// f: func(T) X
+	// returns value with type X.
+	Fold(z, f interface{}) interface{}
+
+	// GetOrElse returns the value if this is Valid,
+	// or z if this is Invalid.
+	// z: func() X or value with type X
+	GetOrElse(z interface{}) interface{}
+
+	// ToOption returns Some of the value if this is Valid, or None.
+	ToOption() Option
+
+	// ToEither returns Right of the value if this is Valid,
+	// or Left of Errors.
+	ToEither() Either
+
+	// ToTry returns Success of the value if this is Valid,
+	// or Failure of Errors.
+	ToTry() Try
+}
+
+type traitValidated struct {
+	valid bool
+	v     reflect.Value
+}
+
+var _ Validated = &traitValidated{}
+
+func (v *traitValidated) Get() interface{} {
+	return v.v.Interface()
+}
+
+func (v *traitValidated) rv() reflect.Value {
+	return v.v
+}
+
+func (v *traitValidated) String() string {
+	if v.valid {
+		return fmt.Sprintf("Valid(%v)", v.Get())
+	}
+	return fmt.Sprintf("Invalid(%v)", v.Get())
+}
+
+func (v *traitValidated) IsValid() bool {
+	return v.valid
+}
+
+func (v *traitValidated) IsInvalid() bool {
+	return !v.valid
+}
+
+func (v *traitValidated) Errors() Errors {
+	if v.valid {
+		return nil
+	}
+	return v.Get().(Errors)
+}
+
+func (v *traitValidated) Map(f interface{}) Validated {
+	if v.valid {
+		return validatedCBF(funcOf(f).call(v.v))
+	}
+	return v
+}
+
+func (v *traitValidated) AndThen(f interface{}) Validated {
+	if v.valid {
+		return funcOf(f).call(v.v).Interface().(Validated)
+	}
+	return v
+}
+
+func (v *traitValidated) Zip(that Validated) Validated {
+	return MapNValidated(nil, v, that)
+}
+
+func (v *traitValidated) Foreach(f interface{}) {
+	if v.valid {
+		funcOf(f).call(v.v)
+	}
+}
+
+func (v *traitValidated) Fold(z, f interface{}) interface{} {
+	if v.valid {
+		return funcOf(f).call(v.v).Interface()
+	}
+	return funcOf(z).call(v.v).Interface()
+}
+
+func (v *traitValidated) GetOrElse(z interface{}) interface{} {
+	if v.valid {
+		return v.Get()
+	}
+	return checkAndInvoke(z)
+}
+
+func (v *traitValidated) ToOption() Option {
+	if v.valid {
+		return OptionOf(v.Get())
+	}
+	return None
+}
+
+func (v *traitValidated) ToEither() Either {
+	if v.valid {
+		return RightOf(v.Get())
+	}
+	return LeftOf(v.Get())
+}
+
+func (v *traitValidated) ToTry() Try {
+	if v.valid {
+		return &traitTry{ok: true, v: v.v}
+	}
+	return newTraitTry(false, v.Get())
+}
+
+// ----------------------------------------------------------------------------
+
+func newInvalid(errs Errors) Validated {
+	return &traitValidated{
+		valid: false,
+		v:     reflect.ValueOf(errs),
+	}
+}
+
+func validatedCBF(x ...interface{}) Validated {
+	switch len(x) {
+	case 0:
+		return &traitValidated{
+			valid: true,
+			v:     unitValue,
+		}
+	case 1:
+		switch v := x[0].(type) {
+		case Validated:
+			return v
+		case reflect.Value:
+			return validatedCBF(v.Interface())
+		default:
+			if v == nil {
+				return &traitValidated{
+					valid: true,
+					v:     nullValue,
+				}
+			}
+			return &traitValidated{
+				valid: true,
+				v:     reflect.ValueOf(v),
+			}
+		}
+	default:
+		return &traitValidated{
+			valid: true,
+			v:     reflect.ValueOf(TupleOf(x)),
+		}
+	}
+}
+
+// ValidOf returns Valid of x.
+func ValidOf(x ...interface{}) Validated {
+	return validatedCBF(x...)
+}
+
+// InvalidOf returns Invalid of at least one error.
+// x and each element of more are converted to error, and false is converted to ErrFalse.
+// It panics if x or any element of more is nil.
+func InvalidOf(x interface{}, more ...interface{}) Validated {
+	if x == nil {
+		panic("x is nil")
+	}
+	errs := appendErrors(nil, errorOf(x))
+
+	for _, err := range more {
+		if err == nil {
+			panic("error is nil")
+		}
+		errs = appendErrors(errs, errorOf(err))
+	}
+	return newInvalid(errs)
+}
+
+// ValidatedOf returns a Validated.
+// Return Invalid if last argument is false or error existing, like TryOf.
+func ValidatedOf(x ...interface{}) Validated {
+	return ValidatedFromTry(TryOf(x...))
+}
+
+// ValidatedFromTry returns Valid of the value if t is Success,
+// or Invalid of the failure.
+func ValidatedFromTry(t Try) Validated {
+	if t.OK() {
+		return &traitValidated{valid: true, v: t.rv()}
+	}
+	return InvalidOf(t.Get())
+}
+
+// ValidatedFromEither returns Valid of the Right value,
+// or Invalid of the Left value.
+func ValidatedFromEither(e Either) Validated {
+	if e.IsRight() {
+		return &traitValidated{valid: true, v: e.rv()}
+	}
+	return InvalidOf(e.Get())
+}
+
+// ----------------------------------------------------------------------------
+
+// MapNValidated applies f to values of vs if all of vs are Valid,
+// or returns Invalid with errors from all Invalid.
+// f is func(T1, T2, ..., Tn) X, or nil to combine values into a Tuple.
+// returns Validated[X]
+func MapNValidated(f interface{}, vs ...Validated) Validated {
+	var errs Errors
+	invalid := false
+	values := make([]interface{}, 0, len(vs))
+
+	for _, v := range vs {
+		if v.IsInvalid() {
+			invalid = true
+			errs = append(errs, v.Errors()...)
+			continue
+		}
+		values = append(values, v.Get())
+	}
+
+	if invalid {
+		return newInvalid(errs)
+	}
+
+	var ret Validated
+	switch len(values) {
+	case 0:
+		ret = ValidOf()
+	case 1:
+		ret = ValidOf(values[0])
+	default:
+		ret = ValidOf(TupleOf(values))
+	}
+
+	if f == nil {
+		return ret
+	}
+	return ret.Map(f)
+}
+
+// Map2Validated applies f to values of v1 and v2 if both are Valid,
+// or returns Invalid with errors from both.
+// f: func(T1, T2) X, or nil to return Validated[Tuple2].
+func Map2Validated(v1, v2 Validated, f interface{}) Validated {
+	return MapNValidated(f, v1, v2)
+}
+
+// Map3Validated applies f to values of v1, v2 and v3 if all are Valid,
+// or returns Invalid with errors from all Invalid.
+// f: func(T1, T2, T3) X, or nil to return Validated[Tuple3].
+func Map3Validated(v1, v2, v3 Validated, f interface{}) Validated {
+	return MapNValidated(f, v1, v2, v3)
+}
+
+// Map4Validated applies f to values of v1, v2, v3 and v4 if all are Valid,
+// or returns Invalid with errors from all Invalid.
+// f: func(T1, T2, T3, T4) X, or nil to return Validated[Tuple4].
+func Map4Validated(v1, v2, v3, v4 Validated, f interface{}) Validated {
+	return MapNValidated(f, v1, v2, v3, v4)
+}
+
+// SequenceValidated turns a Slice of Validated inside out.
+// x: Go slice or Slice of Validated.
+// returns Valid of Slice with all values, or Invalid with errors from all Invalid.
+func SequenceValidated(x interface{}) Validated {
+	return TraverseValidated(x, func(v Validated) Validated {
+		return v
+	})
+}
+
+// TraverseValidated applies f to all elements, and turns results inside out.
+// f: func(T) Validated
+// returns Valid of Slice with all values, or Invalid with errors from all Invalid.
+func TraverseValidated(x, f interface{}) Validated {
+	var ret reflect.Value
+	var errs Errors
+	invalid := false
+
+	s := seqOf(x)
+	fw := funcOf(f)
+
+	for i := 0; i < s.len; i++ {
+		v := fw.call(s.v.Index(i)).Interface().(Validated)
+		if v.IsInvalid() {
+			invalid = true
+			errs = append(errs, v.Errors()...)
+			continue
+		}
+		ret = widenAppendSlice(ret, v.rv())
+	}
+
+	if invalid {
+		return newInvalid(errs)
+	}
+
+	return ValidOf(SliceOf(ret))
+}
@@ -0,0 +1,117 @@
+package monadgo
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func ExampleValidatedOf() {
+	fmt.Println(ValidatedOf(strconv.Atoi("1")))
+	fmt.Println(ValidatedOf(strconv.Atoi("a")))
+	fmt.Println(ValidOf(1, "a"))
+	fmt.Println(InvalidOf(fmt.Errorf("e1"), false))
+
+	// Output:
+	// Valid(1)
+	// Invalid(strconv.Atoi: parsing "a": invalid syntax)
+	// Valid((1,a))
+	// Invalid(e1; false)
+}
+
+func ExampleMap2Validated() {
+	age := func(x int) Validated {
+		if x < 0 {
+			return InvalidOf(fmt.Errorf("negative age %d", x))
+		}
+		return ValidOf(x)
+	}
+
+	name := func(x string) Validated {
+		if x == "" {
+			return InvalidOf(fmt.Errorf("empty name"))
+		}
+		return ValidOf(x)
+	}
+
+	person := func(n string, a int) string {
+		return fmt.Sprintf("%s(%d)", n, a)
+	}
+
+	fmt.Println(Map2Validated(name("a"), age(1), person))
+	fmt.Println(Map2Validated(name(""), age(-1), person))
+	fmt.Println(name("a").Zip(age(1)))
+	fmt.Println(Map3Validated(name(""), age(1), age(-2), nil))
+
+	// Output:
+	// Valid(a(1))
+	// Invalid(empty name; negative age -1)
+	// Valid((a,1))
+	// Invalid(empty name; negative age -2)
+}
+
+func ExampleTraverseValidated() {
+	v := TraverseValidated([]string{"1", "a", "2", "b"}, func(x string) Validated {
+		return ValidatedOf(strconv.Atoi(x))
+	})
+	fmt.Println(v)
+	fmt.Println(len(v.Errors()))
+
+	v = TraverseValidated([]string{"1", "2"}, func(x string) Validated {
+		return ValidatedOf(strconv.Atoi(x))
+	})
+	printGet(v.Get().(Slice).Get())
+
+	// Output:
+	// Invalid(strconv.Atoi: parsing "a": invalid syntax; strconv.Atoi: parsing "b": invalid syntax)
+	// 2
+	// [1 2], []int
+}
+
+func ExampleValidated_ToEither() {
+	fmt.Println(ValidOf(1).ToEither())
+	fmt.Println(InvalidOf(fmt.Errorf("e1")).ToEither())
+	fmt.Println(ValidOf(1).ToTry())
+	fmt.Println(InvalidOf(fmt.Errorf("e1")).ToTry())
+	fmt.Println(ValidatedFromEither(LeftOf("e2")))
+	fmt.Println(ValidatedFromTry(SuccessOf(2)))
+
+	// Output:
+	// Right(1)
+	// Left(e1)
+	// Success(1)
+	// Failure(e1)
+	// Invalid(e2)
+	// Valid(2)
+}
+
+func TestMapNValidated_EmptyErrors(t *testing.T) {
+	invalid := InvalidOf(Errors{})
+	if !invalid.IsInvalid() {
+		t.Fatalf("expect Invalid, but %v", invalid)
+	}
+
+	if v := Map2Validated(ValidOf(1), invalid, nil); v.IsValid() {
+		t.Errorf("expect Invalid, but %v", v)
+	}
+
+	if v := SequenceValidated([]Validated{ValidOf(1), invalid}); v.IsValid() {
+		t.Errorf("expect Invalid, but %v", v)
+	}
+}
+
+func TestInvalidOf_Nil(t *testing.T) {
+	for _, f := range []func(){
+		func() { InvalidOf(nil) },
+		func() { InvalidOf(fmt.Errorf("e1"), nil) },
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("InvalidOf with nil should panic")
+				}
+			}()
+			f()
+		}()
+	}
+}
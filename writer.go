@@ -0,0 +1,144 @@
+package monadgo
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Writer represents cats-like Writer[L, V], a value with a log accumulated through a Monoid.
+type Writer interface {
+	Any
+
+	// Run returns Tuple2(log, value).
+	Run() Tuple2
+
+	// Value returns the value.
+	Value() interface{}
+
+	// Written returns the log.
+	Written() interface{}
+
+	// Map applies f to the value.
+	// f: func(V) X
+	// returns Writer[L, X]
+	Map(f interface{}) Writer
+
+	// FlatMap binds the function f across the value,
+	// and combines log of this and the new Writer.
+	// f: func(V) Writer
+	FlatMap(f interface{}) Writer
+
+	// Tell combines log of this and l.
+	Tell(l interface{}) Writer
+
+	// Reset returns a Writer with the same value and empty log.
+	Reset() Writer
+}
+
+type writer struct {
+	m   Monoid
+	log interface{}
+	v   reflect.Value
+}
+
+var _ Writer = &writer{}
+
+func (w *writer) Get() interface{} {
+	return w.v.Interface()
+}
+
+func (w *writer) rv() reflect.Value {
+	return w.v
+}
+
+func (w *writer) String() string {
+	return fmt.Sprintf("Writer(%v,%v)", w.log, w.Get())
+}
+
+func (w *writer) Run() Tuple2 {
+	return Tuple2Of(w.log, w.Get())
+}
+
+func (w *writer) Value() interface{} {
+	return w.Get()
+}
+
+func (w *writer) Written() interface{} {
+	return w.log
+}
+
+func (w *writer) Map(f interface{}) Writer {
+	return &writer{
+		m:   w.m,
+		log: w.log,
+		v:   funcOf(f).call(w.v),
+	}
+}
+
+func (w *writer) FlatMap(f interface{}) Writer {
+	next := funcOf(f).call(w.v).Interface().(Writer)
+	return &writer{
+		m:   w.m,
+		log: w.m.Combine(w.log, next.Written()),
+		v:   next.rv(),
+	}
+}
+
+func (w *writer) Tell(l interface{}) Writer {
+	return &writer{
+		m:   w.m,
+		log: w.m.Combine(w.log, l),
+		v:   w.v,
+	}
+}
+
+func (w *writer) Reset() Writer {
+	return &writer{
+		m:   w.m,
+		log: w.m.Empty(),
+		v:   w.v,
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// WriterOf returns a Writer with log l and value x.
+func WriterOf(m Monoid, l, x interface{}) Writer {
+	return &writer{
+		m:   m,
+		log: l,
+		v:   valueOf(x),
+	}
+}
+
+// PureWriter returns a Writer with empty log and value x.
+func PureWriter(m Monoid, x interface{}) Writer {
+	return WriterOf(m, m.Empty(), x)
+}
+
+// TellWriter returns a Writer with log l and Unit value.
+func TellWriter(m Monoid, l interface{}) Writer {
+	return &writer{
+		m:   m,
+		log: l,
+		v:   unitValue,
+	}
+}
+
+// SequenceWriter turns a Slice of Writer inside out.
+// x: Go slice or Slice of Writer.
+// returns Writer of Slice with all values, and combined log of all Writers.
+func SequenceWriter(m Monoid, x interface{}) Writer {
+	var ret reflect.Value
+
+	s := seqOf(x)
+	log := m.Empty()
+
+	for i := 0; i < s.len; i++ {
+		w := s.v.Index(i).Interface().(Writer)
+		log = m.Combine(log, w.Written())
+		ret = widenAppendSlice(ret, w.rv())
+	}
+
+	return WriterOf(m, log, SliceOf(ret))
+}
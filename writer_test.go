@@ -0,0 +1,41 @@
+package monadgo
+
+import (
+	"fmt"
+)
+
+func ExampleWriterOf() {
+	logs := SliceMonoidOf([]string{})
+
+	double := func(x int) Writer {
+		return WriterOf(logs, []string{fmt.Sprintf("double %d", x)}, x*2)
+	}
+
+	w := PureWriter(logs, 1).FlatMap(double).FlatMap(double).Map(func(x int) string {
+		return fmt.Sprintf("result %d", x)
+	}).Tell([]string{"done"})
+
+	fmt.Println(w)
+	printGet(w.Value())
+	printGet(w.Written())
+	fmt.Println(w.Reset())
+
+	// Output:
+	// Writer([double 1 double 2 done],result 4)
+	// result 4, string
+	// [double 1 double 2 done], []string
+	// Writer([],result 4)
+}
+
+func ExampleSequenceWriter() {
+	w := SequenceWriter(StringMonoid, []Writer{
+		WriterOf(StringMonoid, "a", 1),
+		TellWriter(StringMonoid, "b"),
+		WriterOf(StringMonoid, "c", 3),
+	})
+
+	fmt.Println(w.Run())
+
+	// Output:
+	// (abc,[1 Void 3])
+}
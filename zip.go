@@ -0,0 +1,142 @@
+package monadgo
+
+import (
+	"reflect"
+)
+
+// mapNOption applies f to values of xs if all of xs are Some, or returns None.
+// f is func(T1, T2, ..., Tn) X, or nil to combine values into a Tuple.
+func mapNOption(f interface{}, xs ...Option) Option {
+	values := make([]interface{}, len(xs))
+	for i, x := range xs {
+		if !x.Defined() {
+			return None
+		}
+		values[i] = x.Get()
+	}
+
+	ret := SomeOf(values...)
+	if f == nil {
+		return ret
+	}
+	return ret.Map(f)
+}
+
+// mapNTry applies f to values of xs if all of xs are Success, or returns the first Failure.
+// f is func(T1, T2, ..., Tn) X, or nil to combine values into a Tuple.
+func mapNTry(f interface{}, xs ...Try) Try {
+	values := make([]interface{}, len(xs))
+	for i, x := range xs {
+		if x.Failed() {
+			return x
+		}
+		values[i] = x.Get()
+	}
+
+	ret := newTraitTry(true, TupleOf(values))
+	if f == nil {
+		return ret
+	}
+	return ret.Map(f)
+}
+
+// mapNEither applies f to values of xs if all of xs are Right, or returns the first Left.
+// f is func(T1, T2, ..., Tn) X, or nil to combine values into a Tuple.
+func mapNEither(f interface{}, xs ...Either) Either {
+	values := make([]interface{}, len(xs))
+	for i, x := range xs {
+		if x.IsLeft() {
+			return x
+		}
+		values[i] = x.Get()
+	}
+
+	ret := RightOf(values...)
+	if f == nil {
+		return ret
+	}
+	return ret.Map(f)
+}
+
+// mapNFuture applies f to values of xs when all of xs are completed successfully.
+// xs are waited concurrently, and the returned Future fails as soon as any of xs fails.
+// f is func(T1, T2, ..., Tn) X, or nil to combine values into a Tuple.
+func mapNFuture(f interface{}, xs ...Future) Future {
+	ret := SequenceFuture(xs).Map(func(s Slice) Tuple {
+		values := make([]interface{}, s.Len())
+		v := reflect.ValueOf(s.Get())
+		for i := range values {
+			values[i] = v.Index(i).Interface()
+		}
+		return TupleOf(values)
+	})
+
+	if f == nil {
+		return ret
+	}
+	return ret.Map(f)
+}
+
+// mapN dispatches to mapN of the kind of x1.
+func mapN(f interface{}, x1 interface{}, xs ...interface{}) interface{} {
+	switch v := x1.(type) {
+	case Option:
+		opts := []Option{v}
+		for _, x := range xs {
+			opts = append(opts, x.(Option))
+		}
+		return mapNOption(f, opts...)
+	case Try:
+		tries := []Try{v}
+		for _, x := range xs {
+			tries = append(tries, x.(Try))
+		}
+		return mapNTry(f, tries...)
+	case Either:
+		eithers := []Either{v}
+		for _, x := range xs {
+			eithers = append(eithers, x.(Either))
+		}
+		return mapNEither(f, eithers...)
+	case Future:
+		futures := []Future{v}
+		for _, x := range xs {
+			futures = append(futures, x.(Future))
+		}
+		return mapNFuture(f, futures...)
+	case Validated:
+		vs := []Validated{v}
+		for _, x := range xs {
+			vs = append(vs, x.(Validated))
+		}
+		return MapNValidated(f, vs...)
+	}
+
+	panic(x1.(Any).String() + " is not Option, Try, Either, Future or Validated")
+}
+
+// ----------------------------------------------------------------------------
+
+// Map2 applies f to values of x1 and x2 with the same kind of Option, Try, Either, Future or Validated.
+// Futures are waited concurrently.
+// f: func(T1, T2) X, or nil to combine values into a Tuple2.
+// returns Option, Try, Either, Future or Validated of X.
+func Map2(x1, x2, f interface{}) interface{} {
+	return mapN(f, x1, x2)
+}
+
+// Map3 applies f to values of x1, x2 and x3 with the same kind of Option, Try, Either, Future or Validated.
+// Futures are waited concurrently.
+// f: func(T1, T2, T3) X, or nil to combine values into a Tuple3.
+// returns Option, Try, Either, Future or Validated of X.
+func Map3(x1, x2, x3, f interface{}) interface{} {
+	return mapN(f, x1, x2, x3)
+}
+
+// Map4 applies f to values of x1, x2, x3 and x4 with the same kind of Option, Try, Either, Future or Validated.
+// Futures are waited concurrently.
+// f: func(T1, T2, T3, T4) X, or nil to combine values into a Tuple4.
+// returns Option, Try, Either, Future or Validated of X.
+func Map4(x1, x2, x3, x4, f interface{}) interface{} {
+	return mapN(f, x1, x2, x3, x4)
+}
@@ -0,0 +1,88 @@
+package monadgo
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleMap2() {
+	add := func(a, b int) int {
+		return a + b
+	}
+
+	fmt.Println(SomeOf(1).Zip(SomeOf("a")))
+	fmt.Println(SomeOf(1).ZipWith(None, add))
+	fmt.Println(Map2(SomeOf(1), SomeOf(2), add))
+	fmt.Println(Map3(SomeOf(1), SomeOf(2), SomeOf(3), nil))
+
+	fmt.Println(TryOf(1, nil).Zip(TryOf(2, nil)))
+	fmt.Println(Map2(TryOf(1, nil), TryOf(0, errors.New("oops")), add))
+
+	fmt.Println(RightOf(1).ZipWith(RightOf(2), add))
+	fmt.Println(Map4(RightOf(1), RightOf(2), LeftOf("a"), LeftOf("b"), nil))
+
+	fmt.Println(Map2(ValidOf(1), ValidOf(2), add))
+
+	// Output:
+	// Some((1,a))
+	// None
+	// Some(3)
+	// Some((1,2,3))
+	// Success((1,2))
+	// Failure(oops)
+	// Right(3)
+	// Left(a)
+	// Valid(3)
+}
+
+func TestFuture_Zip(t *testing.T) {
+	start := time.Now()
+
+	f1 := FutureOf(func() int {
+		time.Sleep(200 * time.Millisecond)
+		return 1
+	})
+
+	f2 := FutureOf(func() string {
+		time.Sleep(200 * time.Millisecond)
+		return "a"
+	})
+
+	f := f1.Zip(f2)
+	f.Ready(wait)
+
+	if elapsed := time.Since(start); elapsed >= 400*time.Millisecond {
+		t.Errorf("expect waiting concurrently, but %v", elapsed)
+	}
+
+	v, ok := f.Value().Get().(Tuple2)
+	if !ok || v.V1() != 1 || v.V2() != "a" {
+		t.Errorf("expect (1,a), but %v", f.Value())
+	}
+
+	f3 := FutureOf(func() int {
+		return 3
+	})
+
+	sum := Map3(f1, FutureOf(func() int { return 2 }), f3, func(a, b, c int) int {
+		return a + b + c
+	}).(Future)
+	sum.Ready(wait)
+	if x := sum.Value().Get(); x != 6 {
+		t.Errorf("expect 6, but %v", x)
+	}
+
+	errFailed := errors.New("failed")
+	failed := f1.ZipWith(FutureOf(func() (int, error) {
+		return 0, errFailed
+	}), func(a, b int) int {
+		return a + b
+	})
+	failed.Ready(wait)
+
+	if x, ok := futureOf(failed).result(); !ok || x.OK() || x.Get() != errFailed {
+		t.Errorf("expect Failure(%v), but %v", errFailed, x)
+	}
+}